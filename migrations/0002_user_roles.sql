@@ -0,0 +1,9 @@
+CREATE TABLE user_roles (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
+
+-- Indexes
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles(role);
@@ -0,0 +1,2 @@
+-- Each object key is recorded once so repeated upload confirmations are idempotent
+CREATE UNIQUE INDEX IF NOT EXISTS idx_artifacts_s3_key ON ekyc_artifacts(s3_key);
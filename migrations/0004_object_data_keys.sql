@@ -0,0 +1,11 @@
+-- Wrapped per-object data keys for artifacts encrypted with SSE-C.
+-- Rotating the master key rewrites these rows, never the objects.
+CREATE TABLE IF NOT EXISTS object_data_keys (
+    s3_key TEXT PRIMARY KEY,
+    master_key_id TEXT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    rotated_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_object_data_keys_master_key_id ON object_data_keys(master_key_id);
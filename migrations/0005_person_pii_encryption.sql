@@ -0,0 +1,18 @@
+-- Application-layer encryption of person_pii. Each row gets its own data key,
+-- wrapped under a KMS master key; the sensitive fields are stored as
+-- AES-GCM ciphertexts bound to the session and column.
+ALTER TABLE person_pii
+    ADD COLUMN IF NOT EXISTS master_key_id TEXT,
+    ADD COLUMN IF NOT EXISTS wrapped_key BYTEA,
+    ADD COLUMN IF NOT EXISTS full_name_enc BYTEA,
+    ADD COLUMN IF NOT EXISTS id_number_enc BYTEA,
+    ADD COLUMN IF NOT EXISTS dob_enc BYTEA,
+    ADD COLUMN IF NOT EXISTS address_text_enc BYTEA,
+    -- HMAC-SHA256 blind index of the normalized id_number, for duplicate lookups
+    ADD COLUMN IF NOT EXISTS id_number_bidx TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_person_pii_id_number_bidx ON person_pii(id_number_bidx);
+
+-- The clear columns are emptied by the backfill command
+-- (go run ./pkg/pii/cmd/piibackfill) and will be dropped once it has run
+-- everywhere.
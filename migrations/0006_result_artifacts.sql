@@ -0,0 +1,6 @@
+-- Results produced from a single artifact reference it, so reprocessing a
+-- redelivered upload replaces the earlier result instead of adding another
+ALTER TABLE ekyc_results
+    ADD COLUMN IF NOT EXISTS artifact_id UUID REFERENCES ekyc_artifacts(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_results_artifact_kind ON ekyc_results(artifact_id, kind);
@@ -0,0 +1,16 @@
+-- Active liveness challenges issued to a session. A liveness clip is checked
+-- against the challenge that was open when it was uploaded, and each
+-- challenge answers at most one clip, so a recording cannot be replayed.
+CREATE TABLE IF NOT EXISTS liveness_challenges (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    session_id UUID NOT NULL REFERENCES ekyc_sessions(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    actions TEXT[] NOT NULL DEFAULT '{}',
+    digits TEXT NOT NULL DEFAULT '',
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    artifact_id UUID REFERENCES ekyc_artifacts(id) ON DELETE SET NULL,
+    answered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_liveness_challenges_session ON liveness_challenges(session_id, issued_at DESC);
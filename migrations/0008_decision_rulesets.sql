@@ -0,0 +1,7 @@
+-- Automated decisions record the scoring ruleset that produced them. The
+-- digest is the SHA-256 of the ruleset file, in case a version is reused.
+ALTER TABLE ekyc_decisions
+    ADD COLUMN IF NOT EXISTS ruleset_version TEXT,
+    ADD COLUMN IF NOT EXISTS ruleset_digest TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_decisions_ruleset_version ON ekyc_decisions(ruleset_version);
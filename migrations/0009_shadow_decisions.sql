@@ -0,0 +1,18 @@
+-- Outcomes of candidate scoring rulesets evaluated in shadow next to the
+-- active one. They are recorded against the automated decision they were
+-- evaluated with and never change the session.
+CREATE TABLE IF NOT EXISTS shadow_decisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    decision_id UUID NOT NULL REFERENCES ekyc_decisions(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES ekyc_sessions(id) ON DELETE CASCADE,
+    ruleset_version TEXT NOT NULL,
+    ruleset_digest TEXT NOT NULL,
+    status decision_status NOT NULL,
+    score INT NOT NULL,
+    reasons_json JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (decision_id, ruleset_version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shadow_decisions_session ON shadow_decisions(session_id);
+CREATE INDEX IF NOT EXISTS idx_decisions_created ON ekyc_decisions(created_at);
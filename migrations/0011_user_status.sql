@@ -0,0 +1,5 @@
+-- Disabled users can no longer sign in or refresh their tokens. Roles and
+-- status are read from here on every refresh, so a demotion or a disable
+-- takes effect at the next refresh at the latest.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMPTZ;
@@ -0,0 +1,11 @@
+-- A session is scored at most once per ruleset digest, so a retried Score
+-- call returns the decision already recorded instead of adding another one
+-- and publishing a second kyc.scored event. Manual decisions carry no digest
+-- and are not affected. Earlier duplicates are dropped, keeping the first.
+DELETE FROM ekyc_decisions d
+USING ekyc_decisions earlier
+WHERE d.session_id = earlier.session_id
+  AND d.ruleset_digest = earlier.ruleset_digest
+  AND (d.created_at, d.id) > (earlier.created_at, earlier.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_decisions_session_ruleset ON ekyc_decisions(session_id, ruleset_digest);
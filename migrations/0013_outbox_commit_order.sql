@@ -0,0 +1,11 @@
+-- Outbox rows are published in the order of the transactions that wrote
+-- them. A BIGSERIAL id is taken when a row is inserted, not when its
+-- transaction commits, so a row can become visible after rows with higher
+-- ids were already sent. Each row records the ID of its transaction, and the
+-- relay only publishes rows whose transaction is older than every
+-- transaction still in progress, ordered by transaction and then id.
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS txid xid8 NOT NULL DEFAULT pg_current_xact_id();
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_tx ON outbox(txid, id) WHERE sent_at IS NULL;
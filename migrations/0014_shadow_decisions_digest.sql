@@ -0,0 +1,12 @@
+-- Shadow outcomes are keyed by ruleset digest, as automated decisions are
+-- since 0012. Keyed by version, two candidates sharing a version string
+-- collided, and an edited candidate overwrote the outcomes of its earlier
+-- content. Outcomes already recorded twice under one digest keep the first.
+DELETE FROM shadow_decisions s
+USING shadow_decisions earlier
+WHERE s.decision_id = earlier.decision_id
+  AND s.ruleset_digest = earlier.ruleset_digest
+  AND (s.created_at, s.id) > (earlier.created_at, earlier.id);
+
+ALTER TABLE shadow_decisions DROP CONSTRAINT IF EXISTS shadow_decisions_decision_id_ruleset_version_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shadow_decisions_decision_ruleset ON shadow_decisions(decision_id, ruleset_digest);
@@ -11,6 +11,7 @@ type Config struct {
 	ServiceName string
 	Environment string
 	Port        int
+	GRPCPort    int
 
 	// Database
 	DBHost     string
@@ -30,16 +31,53 @@ type Config struct {
 	NATSHost string
 	NATSPort int
 
+	// JetStream event delivery
+	EventsAckWait        time.Duration
+	EventsMaxDeliver     int
+	EventsRetryDelay     time.Duration
+	EventsMaxRetryDelay  time.Duration
+	EventsStreamMaxAge   time.Duration
+	EventsStreamReplicas int
+	EventsDLQMaxAge      time.Duration
+
+	// Transactional outbox relay
+	EventsOutboxPollInterval time.Duration
+	EventsOutboxBatchSize    int
+	EventsOutboxRetention    time.Duration
+
 	// MinIO
 	MinIOEndpoint        string
 	MinIOAccessKeyID     string
 	MinIOSecretAccessKey string
 	MinIOBucketName      string
 	MinIOUseSSL          bool
+	MinIONotifyARN       string
+	MinIONotifySubject   string
+	MinIOWebhookToken    string
+
+	// Artifact transfers
+	StoragePublicURL string
 
 	// JWT
 	JWTSecret     string
 	JWTExpiration time.Duration
+	JWTIssuer     string
+	JWKSURL       string
+
+	// mTLS
+	TLSCAFile         string
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSReloadInterval time.Duration
+	TLSTrustDomain    string
+
+	// Downstream services
+	IdentityGRPCAddr string
+
+	// KMS
+	KMSProvider      string
+	KMSMasterKeyFile string
+	PIIBlindIndexKey string
 
 	// OpenTelemetry
 	OTELCollectorEndpoint string
@@ -49,8 +87,40 @@ type Config struct {
 	RateLimitRequests int
 	RateLimitWindow   time.Duration
 
-	// Liveness Test
-	LivenessForceFail bool
+	// Liveness
+	LivenessForceFail     bool
+	LivenessMode          string
+	LivenessAnalyzer      string
+	LivenessAnalyzerURL   string
+	LivenessChallengeTTL  time.Duration
+	LivenessThreshold     float64
+	LivenessMaxAttempts   int
+	LivenessRetryDelay    time.Duration
+	LivenessMaxRetryDelay time.Duration
+
+	// OCR
+	OCREngine        string
+	OCRFixtureDir    string
+	OCRTesseractPath string
+	OCRLanguages     string
+	OCRMaxAttempts   int
+	OCRRetryDelay    time.Duration
+	OCRMaxRetryDelay time.Duration
+
+	// Face match
+	FaceEmbedder           string
+	FaceModelPath          string
+	FaceDetectorModelPath  string
+	ONNXRuntimeLibrary     string
+	FaceMatchThreshold     float64
+	FaceMatchThresholds    string
+	FaceMatchMaxAttempts   int
+	FaceMatchRetryDelay    time.Duration
+	FaceMatchMaxRetryDelay time.Duration
+
+	// Scoring
+	ScoringRuleset           string
+	ScoringCandidateRulesets string
 }
 
 func Load() *Config {
@@ -58,6 +128,7 @@ func Load() *Config {
 		ServiceName: getEnv("SERVICE_NAME", "unknown"),
 		Environment: getEnv("ENVIRONMENT", "development"),
 		Port:        getEnvAsInt("PORT", 8080),
+		GRPCPort:    getEnvAsInt("GRPC_PORT", 9090),
 
 		// Database
 		DBHost:     getEnv("DB_HOST", "localhost"),
@@ -77,16 +148,53 @@ func Load() *Config {
 		NATSHost: getEnv("NATS_HOST", "localhost"),
 		NATSPort: getEnvAsInt("NATS_PORT", 4222),
 
+		// JetStream event delivery
+		EventsAckWait:        getEnvAsDuration("EVENTS_ACK_WAIT", 30*time.Second),
+		EventsMaxDeliver:     getEnvAsInt("EVENTS_MAX_DELIVER", 5),
+		EventsRetryDelay:     getEnvAsDuration("EVENTS_RETRY_DELAY", 2*time.Second),
+		EventsMaxRetryDelay:  getEnvAsDuration("EVENTS_MAX_RETRY_DELAY", time.Minute),
+		EventsStreamMaxAge:   getEnvAsDuration("EVENTS_STREAM_MAX_AGE", 7*24*time.Hour),
+		EventsStreamReplicas: getEnvAsInt("EVENTS_STREAM_REPLICAS", 1),
+		EventsDLQMaxAge:      getEnvAsDuration("EVENTS_DLQ_MAX_AGE", 30*24*time.Hour),
+
+		// Transactional outbox relay
+		EventsOutboxPollInterval: getEnvAsDuration("EVENTS_OUTBOX_POLL_INTERVAL", 500*time.Millisecond),
+		EventsOutboxBatchSize:    getEnvAsInt("EVENTS_OUTBOX_BATCH_SIZE", 100),
+		EventsOutboxRetention:    getEnvAsDuration("EVENTS_OUTBOX_RETENTION", 7*24*time.Hour),
+
 		// MinIO
 		MinIOEndpoint:        getEnv("MINIO_ENDPOINT", "localhost:9000"),
 		MinIOAccessKeyID:     getEnv("MINIO_ACCESS_KEY_ID", "minioadmin"),
 		MinIOSecretAccessKey: getEnv("MINIO_SECRET_ACCESS_KEY", "minioadmin"),
 		MinIOBucketName:      getEnv("MINIO_BUCKET_NAME", "ekyc"),
 		MinIOUseSSL:          getEnvAsBool("MINIO_USE_SSL", false),
+		MinIONotifyARN:       getEnv("MINIO_NOTIFY_ARN", "arn:minio:sqs::PRIMARY:nats"),
+		MinIONotifySubject:   getEnv("MINIO_NOTIFY_SUBJECT", "minio.events"),
+		MinIOWebhookToken:    getEnv("MINIO_WEBHOOK_TOKEN", ""),
+
+		// Artifact transfers
+		StoragePublicURL: getEnv("STORAGE_PUBLIC_URL", "http://localhost:8086"),
 
 		// JWT
 		JWTSecret:     getEnv("JWT_SECRET", "your-secret-key"),
 		JWTExpiration: getEnvAsDuration("JWT_EXPIRATION", 24*time.Hour),
+		JWTIssuer:     getEnv("JWT_ISSUER", "ekyc-api-gateway"),
+		JWKSURL:       getEnv("JWKS_URL", "http://api-gateway:8080/.well-known/jwks.json"),
+
+		// mTLS
+		TLSCAFile:         getEnv("TLS_CA_FILE", ""),
+		TLSCertFile:       getEnv("TLS_CERT_FILE", ""),
+		TLSKeyFile:        getEnv("TLS_KEY_FILE", ""),
+		TLSReloadInterval: getEnvAsDuration("TLS_RELOAD_INTERVAL", 30*time.Second),
+		TLSTrustDomain:    getEnv("TLS_TRUST_DOMAIN", "ekyc.local"),
+
+		// Downstream services
+		IdentityGRPCAddr: getEnv("IDENTITY_GRPC_ADDR", "identity:9090"),
+
+		// KMS
+		KMSProvider:      getEnv("KMS_PROVIDER", "local"),
+		KMSMasterKeyFile: getEnv("KMS_MASTER_KEY_FILE", ""),
+		PIIBlindIndexKey: getEnv("PII_BLIND_INDEX_KEY", ""),
 
 		// OpenTelemetry
 		OTELCollectorEndpoint: getEnv("OTEL_COLLECTOR_ENDPOINT", "localhost:4317"),
@@ -96,13 +204,53 @@ func Load() *Config {
 		RateLimitRequests: getEnvAsInt("RATE_LIMIT_REQUESTS", 100),
 		RateLimitWindow:   getEnvAsDuration("RATE_LIMIT_WINDOW", time.Minute),
 
-		// Liveness Test
-		LivenessForceFail: getEnvAsBool("LIVENESS_FORCE_FAIL", false),
+		// Liveness
+		LivenessForceFail:     getEnvAsBool("LIVENESS_FORCE_FAIL", false),
+		LivenessMode:          getEnv("LIVENESS_MODE", "active"),
+		LivenessAnalyzer:      getEnv("LIVENESS_ANALYZER", "http"),
+		LivenessAnalyzerURL:   getEnv("LIVENESS_ANALYZER_URL", "http://liveness-analyzer:8000"),
+		LivenessChallengeTTL:  getEnvAsDuration("LIVENESS_CHALLENGE_TTL", 5*time.Minute),
+		LivenessThreshold:     getEnvAsFloat("LIVENESS_THRESHOLD", 0.80),
+		LivenessMaxAttempts:   getEnvAsInt("LIVENESS_MAX_ATTEMPTS", 6),
+		LivenessRetryDelay:    getEnvAsDuration("LIVENESS_RETRY_DELAY", time.Second),
+		LivenessMaxRetryDelay: getEnvAsDuration("LIVENESS_MAX_RETRY_DELAY", 30*time.Second),
+
+		// OCR
+		OCREngine:        getEnv("OCR_ENGINE", "tesseract"),
+		OCRFixtureDir:    getEnv("OCR_FIXTURE_DIR", ""),
+		OCRTesseractPath: getEnv("OCR_TESSERACT_PATH", "tesseract"),
+		OCRLanguages:     getEnv("OCR_LANGUAGES", "vie+eng"),
+		OCRMaxAttempts:   getEnvAsInt("OCR_MAX_ATTEMPTS", 3),
+		OCRRetryDelay:    getEnvAsDuration("OCR_RETRY_DELAY", 10*time.Second),
+		OCRMaxRetryDelay: getEnvAsDuration("OCR_MAX_RETRY_DELAY", 2*time.Minute),
+
+		// Face match
+		FaceEmbedder:           getEnv("FACE_EMBEDDER", "onnx"),
+		FaceModelPath:          getEnv("FACE_MODEL_PATH", "/models/face/arcface.onnx"),
+		FaceDetectorModelPath:  getEnv("FACE_DETECTOR_MODEL_PATH", "/models/face/ultraface-rfb-320.onnx"),
+		ONNXRuntimeLibrary:     getEnv("ONNX_RUNTIME_LIBRARY", "/usr/local/lib/libonnxruntime.so"),
+		FaceMatchThreshold:     getEnvAsFloat("FACE_MATCH_THRESHOLD", 0.40),
+		FaceMatchThresholds:    getEnv("FACE_MATCH_THRESHOLDS", "CCCD=0.40,CMND=0.35,PASSPORT=0.45"),
+		FaceMatchMaxAttempts:   getEnvAsInt("FACE_MATCH_MAX_ATTEMPTS", 4),
+		FaceMatchRetryDelay:    getEnvAsDuration("FACE_MATCH_RETRY_DELAY", 5*time.Second),
+		FaceMatchMaxRetryDelay: getEnvAsDuration("FACE_MATCH_MAX_RETRY_DELAY", time.Minute),
+
+		// Scoring
+		ScoringRuleset:           getEnv("SCORING_RULESET", "rules/v1.yaml"),
+		ScoringCandidateRulesets: getEnv("SCORING_CANDIDATE_RULESETS", ""),
 	}
 
 	return cfg
 }
 
+func (c *Config) GetHTTPAddr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func (c *Config) GetGRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
 func (c *Config) GetDBConnString() string {
 	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		c.DBHost, c.DBPort, c.DBUser, c.DBPassword, c.DBName, c.DBSSLMode)
@@ -132,6 +280,15 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
 		if boolValue, err := strconv.ParseBool(value); err == nil {
@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: pkg/contracts/proto/ekyc.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	IdentityService_CreateSession_FullMethodName      = "/ekyc.IdentityService/CreateSession"
+	IdentityService_GetSessionStatus_FullMethodName   = "/ekyc.IdentityService/GetSessionStatus"
+	IdentityService_ApplyAdminDecision_FullMethodName = "/ekyc.IdentityService/ApplyAdminDecision"
+	IdentityService_DocumentUploaded_FullMethodName   = "/ekyc.IdentityService/DocumentUploaded"
+	IdentityService_SelfieUploaded_FullMethodName     = "/ekyc.IdentityService/SelfieUploaded"
+	IdentityService_LivenessUploaded_FullMethodName   = "/ekyc.IdentityService/LivenessUploaded"
+)
+
+// IdentityServiceClient is the client API for IdentityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdentityServiceClient interface {
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	GetSessionStatus(ctx context.Context, in *GetSessionStatusRequest, opts ...grpc.CallOption) (*GetSessionStatusResponse, error)
+	ApplyAdminDecision(ctx context.Context, in *ApplyAdminDecisionRequest, opts ...grpc.CallOption) (*ApplyAdminDecisionResponse, error)
+	DocumentUploaded(ctx context.Context, in *DocumentUploadedRequest, opts ...grpc.CallOption) (*UploadNotificationResponse, error)
+	SelfieUploaded(ctx context.Context, in *SelfieUploadedRequest, opts ...grpc.CallOption) (*UploadNotificationResponse, error)
+	LivenessUploaded(ctx context.Context, in *LivenessUploadedRequest, opts ...grpc.CallOption) (*UploadNotificationResponse, error)
+}
+
+type identityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdentityServiceClient(cc grpc.ClientConnInterface) IdentityServiceClient {
+	return &identityServiceClient{cc}
+}
+
+func (c *identityServiceClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error) {
+	out := new(CreateSessionResponse)
+	err := c.cc.Invoke(ctx, IdentityService_CreateSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) GetSessionStatus(ctx context.Context, in *GetSessionStatusRequest, opts ...grpc.CallOption) (*GetSessionStatusResponse, error) {
+	out := new(GetSessionStatusResponse)
+	err := c.cc.Invoke(ctx, IdentityService_GetSessionStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) ApplyAdminDecision(ctx context.Context, in *ApplyAdminDecisionRequest, opts ...grpc.CallOption) (*ApplyAdminDecisionResponse, error) {
+	out := new(ApplyAdminDecisionResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ApplyAdminDecision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) DocumentUploaded(ctx context.Context, in *DocumentUploadedRequest, opts ...grpc.CallOption) (*UploadNotificationResponse, error) {
+	out := new(UploadNotificationResponse)
+	err := c.cc.Invoke(ctx, IdentityService_DocumentUploaded_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) SelfieUploaded(ctx context.Context, in *SelfieUploadedRequest, opts ...grpc.CallOption) (*UploadNotificationResponse, error) {
+	out := new(UploadNotificationResponse)
+	err := c.cc.Invoke(ctx, IdentityService_SelfieUploaded_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) LivenessUploaded(ctx context.Context, in *LivenessUploadedRequest, opts ...grpc.CallOption) (*UploadNotificationResponse, error) {
+	out := new(UploadNotificationResponse)
+	err := c.cc.Invoke(ctx, IdentityService_LivenessUploaded_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdentityServiceServer is the server API for IdentityService service.
+// All implementations must embed UnimplementedIdentityServiceServer
+// for forward compatibility
+type IdentityServiceServer interface {
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	GetSessionStatus(context.Context, *GetSessionStatusRequest) (*GetSessionStatusResponse, error)
+	ApplyAdminDecision(context.Context, *ApplyAdminDecisionRequest) (*ApplyAdminDecisionResponse, error)
+	DocumentUploaded(context.Context, *DocumentUploadedRequest) (*UploadNotificationResponse, error)
+	SelfieUploaded(context.Context, *SelfieUploadedRequest) (*UploadNotificationResponse, error)
+	LivenessUploaded(context.Context, *LivenessUploadedRequest) (*UploadNotificationResponse, error)
+	mustEmbedUnimplementedIdentityServiceServer()
+}
+
+// UnimplementedIdentityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIdentityServiceServer struct {
+}
+
+func (UnimplementedIdentityServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedIdentityServiceServer) GetSessionStatus(context.Context, *GetSessionStatusRequest) (*GetSessionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionStatus not implemented")
+}
+func (UnimplementedIdentityServiceServer) ApplyAdminDecision(context.Context, *ApplyAdminDecisionRequest) (*ApplyAdminDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAdminDecision not implemented")
+}
+func (UnimplementedIdentityServiceServer) DocumentUploaded(context.Context, *DocumentUploadedRequest) (*UploadNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentUploaded not implemented")
+}
+func (UnimplementedIdentityServiceServer) SelfieUploaded(context.Context, *SelfieUploadedRequest) (*UploadNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfieUploaded not implemented")
+}
+func (UnimplementedIdentityServiceServer) LivenessUploaded(context.Context, *LivenessUploadedRequest) (*UploadNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LivenessUploaded not implemented")
+}
+func (UnimplementedIdentityServiceServer) mustEmbedUnimplementedIdentityServiceServer() {}
+
+// UnsafeIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdentityServiceServer will
+// result in compilation errors.
+type UnsafeIdentityServiceServer interface {
+	mustEmbedUnimplementedIdentityServiceServer()
+}
+
+func RegisterIdentityServiceServer(s grpc.ServiceRegistrar, srv IdentityServiceServer) {
+	s.RegisterService(&IdentityService_ServiceDesc, srv)
+}
+
+func _IdentityService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_GetSessionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetSessionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_GetSessionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetSessionStatus(ctx, req.(*GetSessionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_ApplyAdminDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyAdminDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ApplyAdminDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ApplyAdminDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ApplyAdminDecision(ctx, req.(*ApplyAdminDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_DocumentUploaded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentUploadedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).DocumentUploaded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_DocumentUploaded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).DocumentUploaded(ctx, req.(*DocumentUploadedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_SelfieUploaded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfieUploadedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).SelfieUploaded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_SelfieUploaded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).SelfieUploaded(ctx, req.(*SelfieUploadedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_LivenessUploaded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LivenessUploadedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).LivenessUploaded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_LivenessUploaded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).LivenessUploaded(ctx, req.(*LivenessUploadedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IdentityService_ServiceDesc is the grpc.ServiceDesc for IdentityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdentityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ekyc.IdentityService",
+	HandlerType: (*IdentityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSession",
+			Handler:    _IdentityService_CreateSession_Handler,
+		},
+		{
+			MethodName: "GetSessionStatus",
+			Handler:    _IdentityService_GetSessionStatus_Handler,
+		},
+		{
+			MethodName: "ApplyAdminDecision",
+			Handler:    _IdentityService_ApplyAdminDecision_Handler,
+		},
+		{
+			MethodName: "DocumentUploaded",
+			Handler:    _IdentityService_DocumentUploaded_Handler,
+		},
+		{
+			MethodName: "SelfieUploaded",
+			Handler:    _IdentityService_SelfieUploaded_Handler,
+		},
+		{
+			MethodName: "LivenessUploaded",
+			Handler:    _IdentityService_LivenessUploaded_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/contracts/proto/ekyc.proto",
+}
+
+const (
+	ScoringService_Score_FullMethodName           = "/ekyc.ScoringService/Score"
+	ScoringService_GetShadowReport_FullMethodName = "/ekyc.ScoringService/GetShadowReport"
+)
+
+// ScoringServiceClient is the client API for ScoringService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScoringServiceClient interface {
+	Score(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*ScoreResponse, error)
+	GetShadowReport(ctx context.Context, in *ShadowReportRequest, opts ...grpc.CallOption) (*ShadowReport, error)
+}
+
+type scoringServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScoringServiceClient(cc grpc.ClientConnInterface) ScoringServiceClient {
+	return &scoringServiceClient{cc}
+}
+
+func (c *scoringServiceClient) Score(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*ScoreResponse, error) {
+	out := new(ScoreResponse)
+	err := c.cc.Invoke(ctx, ScoringService_Score_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoringServiceClient) GetShadowReport(ctx context.Context, in *ShadowReportRequest, opts ...grpc.CallOption) (*ShadowReport, error) {
+	out := new(ShadowReport)
+	err := c.cc.Invoke(ctx, ScoringService_GetShadowReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScoringServiceServer is the server API for ScoringService service.
+// All implementations must embed UnimplementedScoringServiceServer
+// for forward compatibility
+type ScoringServiceServer interface {
+	Score(context.Context, *ScoreRequest) (*ScoreResponse, error)
+	GetShadowReport(context.Context, *ShadowReportRequest) (*ShadowReport, error)
+	mustEmbedUnimplementedScoringServiceServer()
+}
+
+// UnimplementedScoringServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedScoringServiceServer struct {
+}
+
+func (UnimplementedScoringServiceServer) Score(context.Context, *ScoreRequest) (*ScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Score not implemented")
+}
+func (UnimplementedScoringServiceServer) GetShadowReport(context.Context, *ShadowReportRequest) (*ShadowReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShadowReport not implemented")
+}
+func (UnimplementedScoringServiceServer) mustEmbedUnimplementedScoringServiceServer() {}
+
+// UnsafeScoringServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScoringServiceServer will
+// result in compilation errors.
+type UnsafeScoringServiceServer interface {
+	mustEmbedUnimplementedScoringServiceServer()
+}
+
+func RegisterScoringServiceServer(s grpc.ServiceRegistrar, srv ScoringServiceServer) {
+	s.RegisterService(&ScoringService_ServiceDesc, srv)
+}
+
+func _ScoringService_Score_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).Score(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_Score_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).Score(ctx, req.(*ScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoringService_GetShadowReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShadowReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoringServiceServer).GetShadowReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoringService_GetShadowReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoringServiceServer).GetShadowReport(ctx, req.(*ShadowReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScoringService_ServiceDesc is the grpc.ServiceDesc for ScoringService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScoringService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ekyc.ScoringService",
+	HandlerType: (*ScoringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Score",
+			Handler:    _ScoringService_Score_Handler,
+		},
+		{
+			MethodName: "GetShadowReport",
+			Handler:    _ScoringService_GetShadowReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/contracts/proto/ekyc.proto",
+}
+
+const (
+	StorageService_GetPresignedPostPolicy_FullMethodName = "/ekyc.StorageService/GetPresignedPostPolicy"
+	StorageService_GetPresignedGetURL_FullMethodName     = "/ekyc.StorageService/GetPresignedGetURL"
+	StorageService_ConfirmUpload_FullMethodName          = "/ekyc.StorageService/ConfirmUpload"
+)
+
+// StorageServiceClient is the client API for StorageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StorageServiceClient interface {
+	// Authorizes the upload of a new artifact. Despite its name it returns no
+	// presigned bucket policy: files are posted to the storage service with a
+	// single-use token, which checks their size and content type and encrypts
+	// them before they reach the bucket.
+	GetPresignedPostPolicy(ctx context.Context, in *GetPresignedPostPolicyRequest, opts ...grpc.CallOption) (*GetPresignedPostPolicyResponse, error)
+	GetPresignedGetURL(ctx context.Context, in *GetPresignedGetURLRequest, opts ...grpc.CallOption) (*GetPresignedGetURLResponse, error)
+	ConfirmUpload(ctx context.Context, in *ConfirmUploadRequest, opts ...grpc.CallOption) (*ConfirmUploadResponse, error)
+}
+
+type storageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStorageServiceClient(cc grpc.ClientConnInterface) StorageServiceClient {
+	return &storageServiceClient{cc}
+}
+
+func (c *storageServiceClient) GetPresignedPostPolicy(ctx context.Context, in *GetPresignedPostPolicyRequest, opts ...grpc.CallOption) (*GetPresignedPostPolicyResponse, error) {
+	out := new(GetPresignedPostPolicyResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPresignedPostPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetPresignedGetURL(ctx context.Context, in *GetPresignedGetURLRequest, opts ...grpc.CallOption) (*GetPresignedGetURLResponse, error) {
+	out := new(GetPresignedGetURLResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPresignedGetURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) ConfirmUpload(ctx context.Context, in *ConfirmUploadRequest, opts ...grpc.CallOption) (*ConfirmUploadResponse, error) {
+	out := new(ConfirmUploadResponse)
+	err := c.cc.Invoke(ctx, StorageService_ConfirmUpload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StorageServiceServer is the server API for StorageService service.
+// All implementations must embed UnimplementedStorageServiceServer
+// for forward compatibility
+type StorageServiceServer interface {
+	// Authorizes the upload of a new artifact. Despite its name it returns no
+	// presigned bucket policy: files are posted to the storage service with a
+	// single-use token, which checks their size and content type and encrypts
+	// them before they reach the bucket.
+	GetPresignedPostPolicy(context.Context, *GetPresignedPostPolicyRequest) (*GetPresignedPostPolicyResponse, error)
+	GetPresignedGetURL(context.Context, *GetPresignedGetURLRequest) (*GetPresignedGetURLResponse, error)
+	ConfirmUpload(context.Context, *ConfirmUploadRequest) (*ConfirmUploadResponse, error)
+	mustEmbedUnimplementedStorageServiceServer()
+}
+
+// UnimplementedStorageServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStorageServiceServer struct {
+}
+
+func (UnimplementedStorageServiceServer) GetPresignedPostPolicy(context.Context, *GetPresignedPostPolicyRequest) (*GetPresignedPostPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresignedPostPolicy not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPresignedGetURL(context.Context, *GetPresignedGetURLRequest) (*GetPresignedGetURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresignedGetURL not implemented")
+}
+func (UnimplementedStorageServiceServer) ConfirmUpload(context.Context, *ConfirmUploadRequest) (*ConfirmUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmUpload not implemented")
+}
+func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
+
+// UnsafeStorageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StorageServiceServer will
+// result in compilation errors.
+type UnsafeStorageServiceServer interface {
+	mustEmbedUnimplementedStorageServiceServer()
+}
+
+func RegisterStorageServiceServer(s grpc.ServiceRegistrar, srv StorageServiceServer) {
+	s.RegisterService(&StorageService_ServiceDesc, srv)
+}
+
+func _StorageService_GetPresignedPostPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresignedPostPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetPresignedPostPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetPresignedPostPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetPresignedPostPolicy(ctx, req.(*GetPresignedPostPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetPresignedGetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresignedGetURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetPresignedGetURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetPresignedGetURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetPresignedGetURL(ctx, req.(*GetPresignedGetURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_ConfirmUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ConfirmUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_ConfirmUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ConfirmUpload(ctx, req.(*ConfirmUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StorageService_ServiceDesc is the grpc.ServiceDesc for StorageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StorageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ekyc.StorageService",
+	HandlerType: (*StorageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPresignedPostPolicy",
+			Handler:    _StorageService_GetPresignedPostPolicy_Handler,
+		},
+		{
+			MethodName: "GetPresignedGetURL",
+			Handler:    _StorageService_GetPresignedGetURL_Handler,
+		},
+		{
+			MethodName: "ConfirmUpload",
+			Handler:    _StorageService_ConfirmUpload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/contracts/proto/ekyc.proto",
+}
+
+const (
+	AuthService_SignIn_FullMethodName  = "/ekyc.AuthService/SignIn"
+	AuthService_SignUp_FullMethodName  = "/ekyc.AuthService/SignUp"
+	AuthService_GetUser_FullMethodName = "/ekyc.AuthService/GetUser"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, AuthService_SignIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error) {
+	out := new(SignUpResponse)
+	err := c.cc.Invoke(ctx, AuthService_SignUp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility
+type AuthServiceServer interface {
+	SignIn(context.Context, *SignInRequest) (*SignInResponse, error)
+	SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthServiceServer struct {
+}
+
+func (UnimplementedAuthServiceServer) SignIn(context.Context, *SignInRequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignIn not implemented")
+}
+func (UnimplementedAuthServiceServer) SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
+}
+func (UnimplementedAuthServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_SignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignIn(ctx, req.(*SignInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignUp(ctx, req.(*SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ekyc.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignIn",
+			Handler:    _AuthService_SignIn_Handler,
+		},
+		{
+			MethodName: "SignUp",
+			Handler:    _AuthService_SignUp_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _AuthService_GetUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/contracts/proto/ekyc.proto",
+}
+
+const (
+	AdminService_ListSessions_FullMethodName     = "/ekyc.AdminService/ListSessions"
+	AdminService_GetSessionDetail_FullMethodName = "/ekyc.AdminService/GetSessionDetail"
+	AdminService_ApplyDecision_FullMethodName    = "/ekyc.AdminService/ApplyDecision"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*SessionListResponse, error)
+	GetSessionDetail(ctx context.Context, in *GetSessionDetailRequest, opts ...grpc.CallOption) (*SessionDetailResponse, error)
+	ApplyDecision(ctx context.Context, in *ApplyDecisionRequest, opts ...grpc.CallOption) (*ApplyDecisionResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*SessionListResponse, error) {
+	out := new(SessionListResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetSessionDetail(ctx context.Context, in *GetSessionDetailRequest, opts ...grpc.CallOption) (*SessionDetailResponse, error) {
+	out := new(SessionDetailResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetSessionDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApplyDecision(ctx context.Context, in *ApplyDecisionRequest, opts ...grpc.CallOption) (*ApplyDecisionResponse, error) {
+	out := new(ApplyDecisionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ApplyDecision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	ListSessions(context.Context, *ListSessionsRequest) (*SessionListResponse, error)
+	GetSessionDetail(context.Context, *GetSessionDetailRequest) (*SessionDetailResponse, error)
+	ApplyDecision(context.Context, *ApplyDecisionRequest) (*ApplyDecisionResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*SessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAdminServiceServer) GetSessionDetail(context.Context, *GetSessionDetailRequest) (*SessionDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionDetail not implemented")
+}
+func (UnimplementedAdminServiceServer) ApplyDecision(context.Context, *ApplyDecisionRequest) (*ApplyDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDecision not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSessionDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSessionDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetSessionDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSessionDetail(ctx, req.(*GetSessionDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApplyDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApplyDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ApplyDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApplyDecision(ctx, req.(*ApplyDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ekyc.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSessions",
+			Handler:    _AdminService_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSessionDetail",
+			Handler:    _AdminService_GetSessionDetail_Handler,
+		},
+		{
+			MethodName: "ApplyDecision",
+			Handler:    _AdminService_ApplyDecision_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/contracts/proto/ekyc.proto",
+}
+
+const (
+	LivenessService_GetChallenge_FullMethodName = "/ekyc.LivenessService/GetChallenge"
+)
+
+// LivenessServiceClient is the client API for LivenessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LivenessServiceClient interface {
+	GetChallenge(ctx context.Context, in *GetLivenessChallengeRequest, opts ...grpc.CallOption) (*LivenessChallenge, error)
+}
+
+type livenessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLivenessServiceClient(cc grpc.ClientConnInterface) LivenessServiceClient {
+	return &livenessServiceClient{cc}
+}
+
+func (c *livenessServiceClient) GetChallenge(ctx context.Context, in *GetLivenessChallengeRequest, opts ...grpc.CallOption) (*LivenessChallenge, error) {
+	out := new(LivenessChallenge)
+	err := c.cc.Invoke(ctx, LivenessService_GetChallenge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LivenessServiceServer is the server API for LivenessService service.
+// All implementations must embed UnimplementedLivenessServiceServer
+// for forward compatibility
+type LivenessServiceServer interface {
+	GetChallenge(context.Context, *GetLivenessChallengeRequest) (*LivenessChallenge, error)
+	mustEmbedUnimplementedLivenessServiceServer()
+}
+
+// UnimplementedLivenessServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLivenessServiceServer struct {
+}
+
+func (UnimplementedLivenessServiceServer) GetChallenge(context.Context, *GetLivenessChallengeRequest) (*LivenessChallenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenge not implemented")
+}
+func (UnimplementedLivenessServiceServer) mustEmbedUnimplementedLivenessServiceServer() {}
+
+// UnsafeLivenessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LivenessServiceServer will
+// result in compilation errors.
+type UnsafeLivenessServiceServer interface {
+	mustEmbedUnimplementedLivenessServiceServer()
+}
+
+func RegisterLivenessServiceServer(s grpc.ServiceRegistrar, srv LivenessServiceServer) {
+	s.RegisterService(&LivenessService_ServiceDesc, srv)
+}
+
+func _LivenessService_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLivenessChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LivenessServiceServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LivenessService_GetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LivenessServiceServer).GetChallenge(ctx, req.(*GetLivenessChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LivenessService_ServiceDesc is the grpc.ServiceDesc for LivenessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LivenessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ekyc.LivenessService",
+	HandlerType: (*LivenessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChallenge",
+			Handler:    _LivenessService_GetChallenge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/contracts/proto/ekyc.proto",
+}
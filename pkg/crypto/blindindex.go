@@ -0,0 +1,51 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/config"
+)
+
+// BlindIndex computes deterministic HMAC-SHA256 digests of sensitive values,
+// so equal values can be matched in the database without storing them in
+// the clear. The key must never change once indexes have been written, and
+// it must differ from every encryption key.
+type BlindIndex struct {
+	key []byte
+}
+
+// NewBlindIndex creates a blind index from a 256-bit key
+func NewBlindIndex(key []byte) (*BlindIndex, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid blind index key size %d, want %d", len(key), KeySize)
+	}
+	return &BlindIndex{key: key}, nil
+}
+
+// LoadBlindIndex reads the base64 blind index key from the configuration
+func LoadBlindIndex(cfg *config.Config) (*BlindIndex, error) {
+	if cfg.PIIBlindIndexKey == "" {
+		return nil, fmt.Errorf("PII_BLIND_INDEX_KEY is required")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cfg.PIIBlindIndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode PII_BLIND_INDEX_KEY: %w", err)
+	}
+
+	return NewBlindIndex(key)
+}
+
+// Compute returns the hex digest of value. The domain separates indexes of
+// different fields, so equal values in two fields do not match each other.
+func (b *BlindIndex) Compute(domain, value string) string {
+	mac := hmac.New(sha256.New, b.key)
+	mac.Write([]byte(domain))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
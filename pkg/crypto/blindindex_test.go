@@ -0,0 +1,45 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBlindIndexCompute(t *testing.T) {
+	index, err := NewBlindIndex(bytes.Repeat([]byte{2}, KeySize))
+	if err != nil {
+		t.Fatalf("NewBlindIndex() error = %v", err)
+	}
+	other, err := NewBlindIndex(bytes.Repeat([]byte{3}, KeySize))
+	if err != nil {
+		t.Fatalf("NewBlindIndex() error = %v", err)
+	}
+
+	base := index.Compute("id_number", "001099012345")
+
+	tests := []struct {
+		name  string
+		got   string
+		equal bool
+	}{
+		{name: "same domain and value", got: index.Compute("id_number", "001099012345"), equal: true},
+		{name: "other value", got: index.Compute("id_number", "001099012346")},
+		{name: "other domain", got: index.Compute("full_name", "001099012345")},
+		{name: "domain and value boundary", got: index.Compute("id_numbe", "r001099012345")},
+		{name: "other key", got: other.Compute("id_number", "001099012345")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.got == base) != tt.equal {
+				t.Errorf("Compute() = %s, base %s, want equal %v", tt.got, base, tt.equal)
+			}
+		})
+	}
+}
+
+func TestNewBlindIndexKeySize(t *testing.T) {
+	if _, err := NewBlindIndex(make([]byte, KeySize-1)); err == nil {
+		t.Error("NewBlindIndex() accepted a short key")
+	}
+}
@@ -0,0 +1,37 @@
+// Command kmskey adds a master key to a local KMS key file and makes it the
+// primary. Run it to create the file for development, or to start a
+// master-key rotation:
+//
+//	go run ./pkg/crypto/cmd/kmskey -file deploy/kms/master-keys.json -id 2024-06
+//
+// Services pick up the new primary on restart. Data keys wrapped under older
+// master keys are rewrapped by storage-svc's rotatekeys command for artifacts
+// and by pii's piirotatekeys command for person_pii.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ekyc-backend/pkg/crypto"
+)
+
+func main() {
+	file := flag.String("file", "deploy/kms/master-keys.json", "key file path")
+	id := flag.String("id", time.Now().UTC().Format("2006-01-02"), "ID of the new master key")
+	flag.Parse()
+
+	if err := os.MkdirAll(filepath.Dir(*file), 0o700); err != nil {
+		log.Fatalf("Failed to create key directory: %v", err)
+	}
+
+	if err := crypto.AddMasterKey(*file, *id); err != nil {
+		log.Fatalf("Failed to add master key: %v", err)
+	}
+
+	fmt.Printf("added master key %s as primary\n", *id)
+}
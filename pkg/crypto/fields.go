@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"context"
+	"fmt"
+)
+
+// RecordCipher encrypts the fields of a single database record under the
+// record's own data key. Each field is bound to the record ID and field name,
+// so ciphertexts cannot be swapped between rows or columns unnoticed.
+type RecordCipher struct {
+	recordID string
+	dataKey  []byte
+}
+
+// NewRecordCipher creates a data key for a new record. The wrapped key must be
+// stored alongside the record.
+func NewRecordCipher(ctx context.Context, kms KMS, recordID string) (*RecordCipher, WrappedKey, error) {
+	dataKey, wrapped, err := GenerateDataKey(ctx, kms)
+	if err != nil {
+		return nil, WrappedKey{}, err
+	}
+
+	return &RecordCipher{recordID: recordID, dataKey: dataKey}, wrapped, nil
+}
+
+// OpenRecordCipher unwraps the data key stored with an existing record
+func OpenRecordCipher(ctx context.Context, kms KMS, recordID string, wrapped WrappedKey) (*RecordCipher, error) {
+	dataKey, err := kms.Unwrap(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap record key: %w", err)
+	}
+
+	return &RecordCipher{recordID: recordID, dataKey: dataKey}, nil
+}
+
+// EncryptField encrypts one field value. Empty values stay empty and are
+// returned as nil, so they can be stored as NULL.
+func (c *RecordCipher) EncryptField(field, value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	ciphertext, err := Encrypt(c.dataKey, []byte(value), c.additionalData(field))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt %s: %w", field, err)
+	}
+	return ciphertext, nil
+}
+
+// DecryptField decrypts a value produced by EncryptField
+func (c *RecordCipher) DecryptField(field string, ciphertext []byte) (string, error) {
+	if len(ciphertext) == 0 {
+		return "", nil
+	}
+
+	plaintext, err := Decrypt(c.dataKey, ciphertext, c.additionalData(field))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt %s: %w", field, err)
+	}
+	return string(plaintext), nil
+}
+
+// additionalData binds a field ciphertext to its record and column
+func (c *RecordCipher) additionalData(field string) []byte {
+	return []byte(c.recordID + "/" + field)
+}
@@ -0,0 +1,100 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func newTestKMS(t *testing.T) *LocalKMS {
+	t.Helper()
+
+	kms, err := NewLocalKMS("k1", map[string][]byte{"k1": bytes.Repeat([]byte{1}, KeySize)})
+	if err != nil {
+		t.Fatalf("NewLocalKMS() error = %v", err)
+	}
+	return kms
+}
+
+func TestRecordCipherRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	kms := newTestKMS(t)
+
+	cipher, wrapped, err := NewRecordCipher(ctx, kms, "session-1")
+	if err != nil {
+		t.Fatalf("NewRecordCipher() error = %v", err)
+	}
+
+	ciphertext, err := cipher.EncryptField("full_name", "NGUYEN VAN A")
+	if err != nil {
+		t.Fatalf("EncryptField() error = %v", err)
+	}
+
+	reopened, err := OpenRecordCipher(ctx, kms, "session-1", wrapped)
+	if err != nil {
+		t.Fatalf("OpenRecordCipher() error = %v", err)
+	}
+	got, err := reopened.DecryptField("full_name", ciphertext)
+	if err != nil {
+		t.Fatalf("DecryptField() error = %v", err)
+	}
+	if got != "NGUYEN VAN A" {
+		t.Errorf("DecryptField() = %q, want %q", got, "NGUYEN VAN A")
+	}
+}
+
+func TestRecordCipherBindsRecordAndField(t *testing.T) {
+	ctx := context.Background()
+	kms := newTestKMS(t)
+
+	cipher, wrapped, err := NewRecordCipher(ctx, kms, "session-1")
+	if err != nil {
+		t.Fatalf("NewRecordCipher() error = %v", err)
+	}
+	ciphertext, err := cipher.EncryptField("id_number", "001099012345")
+	if err != nil {
+		t.Fatalf("EncryptField() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		recordID string
+		field    string
+		wantErr  bool
+	}{
+		{name: "same record and field", recordID: "session-1", field: "id_number"},
+		{name: "moved to another column", recordID: "session-1", field: "full_name", wantErr: true},
+		{name: "moved to another row", recordID: "session-2", field: "id_number", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The data key is the same; only the associated data differs
+			reader, err := OpenRecordCipher(ctx, kms, tt.recordID, wrapped)
+			if err != nil {
+				t.Fatalf("OpenRecordCipher() error = %v", err)
+			}
+
+			_, err = reader.DecryptField(tt.field, ciphertext)
+			if tt.wantErr != (err != nil) {
+				t.Errorf("DecryptField() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecordCipherEmptyValues(t *testing.T) {
+	cipher, _, err := NewRecordCipher(context.Background(), newTestKMS(t), "session-1")
+	if err != nil {
+		t.Fatalf("NewRecordCipher() error = %v", err)
+	}
+
+	ciphertext, err := cipher.EncryptField("address_text", "")
+	if err != nil || ciphertext != nil {
+		t.Fatalf("EncryptField(\"\") = %v, %v, want nil, nil", ciphertext, err)
+	}
+	plaintext, err := cipher.DecryptField("address_text", nil)
+	if err != nil || plaintext != "" {
+		t.Fatalf("DecryptField(nil) = %q, %v, want \"\", nil", plaintext, err)
+	}
+}
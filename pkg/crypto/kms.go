@@ -0,0 +1,96 @@
+package crypto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ProviderLocal selects the file-backed KMS
+const ProviderLocal = "local"
+
+// ErrUnknownMasterKey is returned when a data key was wrapped under a master
+// key the KMS does not hold
+var ErrUnknownMasterKey = errors.New("unknown master key")
+
+// WrappedKey is a data key encrypted under a master key
+type WrappedKey struct {
+	// MasterKeyID identifies the master key the data key is wrapped under
+	MasterKeyID string
+	Ciphertext  []byte
+}
+
+// KMS wraps and unwraps data keys under master keys it never exposes.
+// Implementations may keep several master keys so that data keys wrapped
+// before a rotation can still be unwrapped.
+type KMS interface {
+	// PrimaryKeyID returns the master key new data keys are wrapped under
+	PrimaryKeyID() string
+	// Wrap encrypts a data key under the primary master key
+	Wrap(ctx context.Context, dataKey []byte) (WrappedKey, error)
+	// Unwrap decrypts a data key wrapped under any known master key
+	Unwrap(ctx context.Context, wrapped WrappedKey) ([]byte, error)
+}
+
+// GenerateDataKey returns a fresh data key and its wrapped form
+func GenerateDataKey(ctx context.Context, kms KMS) ([]byte, WrappedKey, error) {
+	dataKey, err := NewKey()
+	if err != nil {
+		return nil, WrappedKey{}, err
+	}
+
+	wrapped, err := kms.Wrap(ctx, dataKey)
+	if err != nil {
+		return nil, WrappedKey{}, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return dataKey, wrapped, nil
+}
+
+// Rewrap re-encrypts a wrapped data key under the primary master key. It
+// reports false when the key is already wrapped under the primary.
+func Rewrap(ctx context.Context, kms KMS, wrapped WrappedKey) (WrappedKey, bool, error) {
+	if wrapped.MasterKeyID == kms.PrimaryKeyID() {
+		return wrapped, false, nil
+	}
+
+	dataKey, err := kms.Unwrap(ctx, wrapped)
+	if err != nil {
+		return WrappedKey{}, false, err
+	}
+
+	rewrapped, err := kms.Wrap(ctx, dataKey)
+	if err != nil {
+		return WrappedKey{}, false, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return rewrapped, true, nil
+}
+
+// LoadKMS builds the KMS selected by the configuration. In development it
+// returns nil when no master key file is configured, leaving encryption off.
+func LoadKMS(cfg *config.Config, log *logger.Logger) (KMS, error) {
+	if cfg.KMSMasterKeyFile == "" {
+		if cfg.Environment == "development" {
+			log.Warn("KMS not configured, artifacts are stored unencrypted")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("KMS_MASTER_KEY_FILE is required outside development")
+	}
+
+	switch cfg.KMSProvider {
+	case ProviderLocal:
+		kms, err := LoadLocalKMS(cfg.KMSMasterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Local KMS loaded", zap.String("primary_key_id", kms.PrimaryKeyID()))
+		return kms, nil
+	default:
+		return nil, fmt.Errorf("unsupported KMS provider %q", cfg.KMSProvider)
+	}
+}
@@ -0,0 +1,140 @@
+package crypto
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LocalKMS keeps master keys in a JSON file on disk. It is meant for
+// development and tests; production deployments plug in a managed KMS.
+type LocalKMS struct {
+	primary string
+	keys    map[string][]byte
+}
+
+// keyFile is the on-disk format of a LocalKMS key file
+type keyFile struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// NewLocalKMS creates a KMS from in-memory master keys
+func NewLocalKMS(primary string, keys map[string][]byte) (*LocalKMS, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("%w: primary %q", ErrUnknownMasterKey, primary)
+	}
+	for id, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("master key %q has size %d, want %d", id, len(key), KeySize)
+		}
+	}
+
+	return &LocalKMS{primary: primary, keys: keys}, nil
+}
+
+// LoadLocalKMS reads a key file written by AddMasterKey
+func LoadLocalKMS(path string) (*LocalKMS, error) {
+	file, err := readKeyFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode master key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+
+	return NewLocalKMS(file.Primary, keys)
+}
+
+// PrimaryKeyID returns the master key new data keys are wrapped under
+func (k *LocalKMS) PrimaryKeyID() string {
+	return k.primary
+}
+
+// Wrap encrypts a data key under the primary master key
+func (k *LocalKMS) Wrap(_ context.Context, dataKey []byte) (WrappedKey, error) {
+	ciphertext, err := Encrypt(k.keys[k.primary], dataKey, []byte(k.primary))
+	if err != nil {
+		return WrappedKey{}, err
+	}
+
+	return WrappedKey{MasterKeyID: k.primary, Ciphertext: ciphertext}, nil
+}
+
+// Unwrap decrypts a data key wrapped under any master key in the file
+func (k *LocalKMS) Unwrap(_ context.Context, wrapped WrappedKey) ([]byte, error) {
+	master, ok := k.keys[wrapped.MasterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMasterKey, wrapped.MasterKeyID)
+	}
+
+	return Decrypt(master, wrapped.Ciphertext, []byte(wrapped.MasterKeyID))
+}
+
+// AddMasterKey generates a master key, stores it in the key file under id and
+// makes it the primary. Earlier keys are kept so existing data keys can still
+// be unwrapped until they are rewrapped. The file is created if missing.
+func AddMasterKey(path, id string) error {
+	file, err := readKeyFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		file, err = &keyFile{Keys: map[string]string{}}, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, exists := file.Keys[id]; exists {
+		return fmt.Errorf("master key %q already exists", id)
+	}
+
+	key, err := NewKey()
+	if err != nil {
+		return err
+	}
+	file.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	file.Primary = id
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal key file: %w", err)
+	}
+
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+
+	return nil
+}
+
+// readKeyFile loads and decodes a key file
+func readKeyFile(path string) (*keyFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var file keyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+	if file.Keys == nil {
+		file.Keys = map[string]string{}
+	}
+
+	return &file, nil
+}
@@ -0,0 +1,6 @@
+package events
+
+// SubjectArtifactUploaded is published once storage-svc has verified an
+// uploaded object, with a proto.ArtifactUploaded. It may be delivered more
+// than once for the same object, so consumers must be idempotent.
+const SubjectArtifactUploaded = "artifact.uploaded"
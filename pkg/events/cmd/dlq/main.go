@@ -0,0 +1,196 @@
+// Command dlq inspects and repairs events dead-lettered by the event bus:
+//
+//	go run ./pkg/events/cmd/dlq list [-subject artifact.uploaded] [-from 1] [-limit 50]
+//	go run ./pkg/events/cmd/dlq show -seq 42
+//	go run ./pkg/events/cmd/dlq edit -seq 42 -file fixed.json
+//	go run ./pkg/events/cmd/dlq replay -seq 42
+//	go run ./pkg/events/cmd/dlq replay -subject artifact.uploaded
+//	go run ./pkg/events/cmd/dlq purge -subject artifact.uploaded
+//
+// Replayed events are published again on their original subject, so every
+// subscriber of that subject receives them. Edit replaces the payload and
+// stores the letter under a new sequence; it prints the new sequence.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const usage = "usage: dlq list|show|edit|replay|purge [flags]"
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	subject := flags.String("subject", "", "original event subject; wildcards allowed")
+	seq := flags.Uint64("seq", 0, "sequence of the dead letter")
+	from := flags.Uint64("from", 1, "first sequence to list")
+	limit := flags.Int("limit", 50, "dead letters listed or replayed at most")
+	file := flags.String("file", "", "new payload for edit, - for stdin")
+	flags.Parse(os.Args[2:])
+
+	cfg := config.Load()
+
+	log := logger.New("dlq")
+	defer log.Sync()
+
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to JetStream", zap.Error(err))
+	}
+	defer bus.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	queue, err := bus.DeadLetters(ctx)
+	if err != nil {
+		log.Fatal("Failed to open the DLQ", zap.Error(err))
+	}
+
+	switch command {
+	case "list":
+		letters, err := queue.List(ctx, *subject, *from, *limit)
+		if err != nil {
+			log.Fatal("Failed to list dead letters", zap.Error(err))
+		}
+		printLetters(letters)
+
+	case "show":
+		requireSeq(*seq)
+		letter, err := queue.Get(ctx, *seq)
+		if err != nil {
+			log.Fatal("Failed to read dead letter", zap.Error(err))
+		}
+		printLetter(letter)
+
+	case "edit":
+		requireSeq(*seq)
+		data, err := readPayload(*file)
+		if err != nil {
+			log.Fatal("Failed to read payload", zap.Error(err))
+		}
+		edited, err := queue.Edit(ctx, *seq, data)
+		if err != nil {
+			log.Fatal("Failed to edit dead letter", zap.Error(err))
+		}
+		fmt.Printf("dead letter %d edited as %d\n", *seq, edited)
+
+	case "replay":
+		if *seq != 0 {
+			if err := queue.Replay(ctx, *seq); err != nil {
+				log.Fatal("Failed to replay dead letter", zap.Error(err))
+			}
+			fmt.Printf("dead letter %d replayed\n", *seq)
+			return
+		}
+		if *subject == "" {
+			log.Fatal("replay needs -seq or -subject")
+		}
+		letters, err := queue.List(ctx, *subject, *from, *limit)
+		if err != nil {
+			log.Fatal("Failed to list dead letters", zap.Error(err))
+		}
+		for _, letter := range letters {
+			if err := queue.Replay(ctx, letter.Sequence); err != nil {
+				log.Fatal("Failed to replay dead letter", zap.Error(err))
+			}
+		}
+		fmt.Printf("%d dead letters replayed\n", len(letters))
+
+	case "purge":
+		if *seq != 0 {
+			if err := queue.Delete(ctx, *seq); err != nil {
+				log.Fatal("Failed to delete dead letter", zap.Error(err))
+			}
+			fmt.Printf("dead letter %d deleted\n", *seq)
+			return
+		}
+		if err := queue.Purge(ctx, *subject); err != nil {
+			log.Fatal("Failed to purge dead letters", zap.Error(err))
+		}
+		if *subject == "" {
+			fmt.Println("all dead letters purged")
+		} else {
+			fmt.Printf("dead letters of %s purged\n", *subject)
+		}
+
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+// requireSeq exits if no sequence was given
+func requireSeq(seq uint64) {
+	if seq == 0 {
+		fmt.Fprintln(os.Stderr, "-seq is required")
+		os.Exit(2)
+	}
+}
+
+// readPayload reads the payload of an edit from a file or stdin
+func readPayload(file string) ([]byte, error) {
+	switch file {
+	case "":
+		return nil, fmt.Errorf("-file is required")
+	case "-":
+		return io.ReadAll(os.Stdin)
+	default:
+		return os.ReadFile(file)
+	}
+}
+
+// printLetters lists dead letters one per line
+func printLetters(letters []events.DeadLetter) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "SEQ\tSUBJECT\tCONSUMER\tATTEMPTS\tFAILED AT\tREASON")
+	for _, letter := range letters {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\n",
+			letter.Sequence, letter.Subject, letter.Consumer, letter.Attempts,
+			letter.FailedAt.Format(time.RFC3339), strings.ReplaceAll(letter.Reason, "\n", " "))
+	}
+	w.Flush()
+}
+
+// printLetter prints a dead letter with its headers and payload as JSON
+func printLetter(letter *events.DeadLetter) {
+	var data interface{} = string(letter.Data)
+	if json.Valid(letter.Data) {
+		data = json.RawMessage(letter.Data)
+	}
+
+	out, err := json.MarshalIndent(map[string]interface{}{
+		"sequence": letter.Sequence,
+		"subject":  letter.Subject,
+		"consumer": letter.Consumer,
+		"attempts": letter.Attempts,
+		"reason":   letter.Reason,
+		"failedAt": letter.FailedAt,
+		"editedAt": letter.EditedAt,
+		"headers":  letter.Header,
+		"data":     data,
+	}, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(string(out))
+}
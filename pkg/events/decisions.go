@@ -0,0 +1,9 @@
+package events
+
+// SubjectSessionScored is published by scoring with every automated
+// decision, with a proto.SessionScored
+const SubjectSessionScored = "kyc.scored"
+
+// SubjectDecisionApplied is published by identity with every admin decision,
+// with a proto.DecisionApplied
+const SubjectDecisionApplied = "kyc.decision_applied"
@@ -0,0 +1,230 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// DLQStream is the stream holding dead-lettered events. An event that fails
+// on subject S is stored under DLQSubjectPrefix + S.
+const (
+	DLQStream        = "EKYC_DLQ"
+	DLQSubjectPrefix = "dlq."
+)
+
+// Headers added to a dead-lettered event next to its original headers
+const (
+	HeaderDLQSubject  = "X-DLQ-Subject"
+	HeaderDLQConsumer = "X-DLQ-Consumer"
+	HeaderDLQStream   = "X-DLQ-Stream"
+	HeaderDLQSequence = "X-DLQ-Sequence"
+	HeaderDLQAttempts = "X-DLQ-Attempts"
+	HeaderDLQReason   = "X-DLQ-Reason"
+	HeaderDLQFailedAt = "X-DLQ-Failed-At"
+	HeaderDLQEditedAt = "X-DLQ-Edited-At"
+)
+
+// deadLetterMsg builds the DLQ message for an event that consumer gave up
+// on. The original headers are kept, so a replay continues the original
+// trace. The message ID identifies the failed delivery, so dead-lettering
+// it twice stores it once.
+func deadLetterMsg(subject string, header nats.Header, data []byte, consumer, stream string, sequence uint64, attempts int, reason error) *nats.Msg {
+	msg := nats.NewMsg(DLQSubjectPrefix + subject)
+	msg.Data = data
+	for key, values := range header {
+		msg.Header[key] = append([]string(nil), values...)
+	}
+
+	msg.Header.Set(HeaderDLQSubject, subject)
+	msg.Header.Set(HeaderDLQConsumer, consumer)
+	msg.Header.Set(HeaderDLQAttempts, strconv.Itoa(attempts))
+	msg.Header.Set(HeaderDLQReason, reason.Error())
+	msg.Header.Set(HeaderDLQFailedAt, time.Now().UTC().Format(time.RFC3339Nano))
+	if stream != "" {
+		msg.Header.Set(HeaderDLQStream, stream)
+		msg.Header.Set(HeaderDLQSequence, strconv.FormatUint(sequence, 10))
+		msg.Header.Set(nats.MsgIdHdr, fmt.Sprintf("%s/%s/%d", consumer, stream, sequence))
+	} else {
+		msg.Header.Del(nats.MsgIdHdr)
+	}
+
+	return msg
+}
+
+// DeadLetter is an event stored in the DLQ
+type DeadLetter struct {
+	// Sequence identifies the dead letter in the DLQ stream
+	Sequence uint64
+	// Subject is the subject the event was published on
+	Subject  string
+	Consumer string
+	Attempts int
+	Reason   string
+	FailedAt time.Time
+	EditedAt *time.Time
+	// Header holds the original headers and the DLQ headers
+	Header nats.Header
+	Data   []byte
+}
+
+// DeadLetterQueue inspects, edits, replays and purges dead-lettered events
+type DeadLetterQueue struct {
+	js     jetstream.JetStream
+	stream jetstream.Stream
+}
+
+// DeadLetters returns the DLQ of the bus's streams
+func (b *JetStreamEventBus) DeadLetters(ctx context.Context) (*DeadLetterQueue, error) {
+	stream, err := b.js.Stream(ctx, DLQStream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stream %s: %w", DLQStream, err)
+	}
+	return &DeadLetterQueue{js: b.js, stream: stream}, nil
+}
+
+// List returns up to limit dead letters from sequence from onwards, oldest
+// first. An empty subject lists every subject; it may contain wildcards.
+func (q *DeadLetterQueue) List(ctx context.Context, subject string, from uint64, limit int) ([]DeadLetter, error) {
+	filter := dlqFilter(subject)
+
+	var letters []DeadLetter
+	for seq := max(from, 1); len(letters) < limit; {
+		raw, err := q.stream.GetMsg(ctx, seq, jetstream.WithGetMsgSubject(filter))
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dead letter after %d: %w", seq, err)
+		}
+		letters = append(letters, toDeadLetter(raw))
+		seq = raw.Sequence + 1
+	}
+
+	return letters, nil
+}
+
+// Get returns the dead letter stored at seq
+func (q *DeadLetterQueue) Get(ctx context.Context, seq uint64) (*DeadLetter, error) {
+	raw, err := q.stream.GetMsg(ctx, seq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dead letter %d: %w", seq, err)
+	}
+	letter := toDeadLetter(raw)
+	return &letter, nil
+}
+
+// Edit replaces the payload of a dead letter. Stored messages cannot be
+// changed, so the edited letter is stored under a new sequence, which is
+// returned, and the old one is deleted.
+func (q *DeadLetterQueue) Edit(ctx context.Context, seq uint64, data []byte) (uint64, error) {
+	letter, err := q.Get(ctx, seq)
+	if err != nil {
+		return 0, err
+	}
+
+	msg := nats.NewMsg(DLQSubjectPrefix + letter.Subject)
+	msg.Data = data
+	msg.Header = letter.Header
+	msg.Header.Del(nats.MsgIdHdr)
+	msg.Header.Set(HeaderDLQEditedAt, time.Now().UTC().Format(time.RFC3339Nano))
+
+	ack, err := q.js.PublishMsg(ctx, msg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to store edited dead letter %d: %w", seq, err)
+	}
+	if err := q.stream.DeleteMsg(ctx, seq); err != nil {
+		return ack.Sequence, fmt.Errorf("failed to delete dead letter %d after editing: %w", seq, err)
+	}
+
+	return ack.Sequence, nil
+}
+
+// Replay republishes a dead letter on its original subject with its original
+// headers and removes it from the DLQ. Every subscriber of the subject
+// receives it again, not only the consumer that gave up on it.
+func (q *DeadLetterQueue) Replay(ctx context.Context, seq uint64) error {
+	letter, err := q.Get(ctx, seq)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(letter.Subject)
+	msg.Data = letter.Data
+	for key, values := range letter.Header {
+		if !strings.HasPrefix(key, "X-DLQ-") {
+			msg.Header[key] = values
+		}
+	}
+	// A replay is deduplicated, but not against the original publish
+	msg.Header.Set(nats.MsgIdHdr, fmt.Sprintf("%s/%d", DLQStream, seq))
+
+	if _, err := q.js.PublishMsg(ctx, msg); err != nil {
+		return fmt.Errorf("failed to replay dead letter %d: %w", seq, err)
+	}
+	if err := q.stream.DeleteMsg(ctx, seq); err != nil {
+		return fmt.Errorf("failed to delete dead letter %d after replay: %w", seq, err)
+	}
+
+	return nil
+}
+
+// Delete removes one dead letter
+func (q *DeadLetterQueue) Delete(ctx context.Context, seq uint64) error {
+	if err := q.stream.DeleteMsg(ctx, seq); err != nil {
+		return fmt.Errorf("failed to delete dead letter %d: %w", seq, err)
+	}
+	return nil
+}
+
+// Purge removes every dead letter of subject, or the whole DLQ if subject
+// is empty
+func (q *DeadLetterQueue) Purge(ctx context.Context, subject string) error {
+	var opts []jetstream.StreamPurgeOpt
+	if subject != "" {
+		opts = append(opts, jetstream.WithPurgeSubject(dlqFilter(subject)))
+	}
+	if err := q.stream.Purge(ctx, opts...); err != nil {
+		return fmt.Errorf("failed to purge dead letters: %w", err)
+	}
+	return nil
+}
+
+// dlqFilter returns the DLQ subject filter for an original subject
+func dlqFilter(subject string) string {
+	if subject == "" {
+		return DLQSubjectPrefix + ">"
+	}
+	return DLQSubjectPrefix + subject
+}
+
+// toDeadLetter reads the DLQ headers of a stored message
+func toDeadLetter(raw *jetstream.RawStreamMsg) DeadLetter {
+	letter := DeadLetter{
+		Sequence: raw.Sequence,
+		Subject:  raw.Header.Get(HeaderDLQSubject),
+		Consumer: raw.Header.Get(HeaderDLQConsumer),
+		Reason:   raw.Header.Get(HeaderDLQReason),
+		Header:   raw.Header,
+		Data:     raw.Data,
+	}
+	if letter.Subject == "" {
+		letter.Subject = strings.TrimPrefix(raw.Subject, DLQSubjectPrefix)
+	}
+	letter.Attempts, _ = strconv.Atoi(raw.Header.Get(HeaderDLQAttempts))
+	if failedAt, err := time.Parse(time.RFC3339Nano, raw.Header.Get(HeaderDLQFailedAt)); err == nil {
+		letter.FailedAt = failedAt
+	} else {
+		letter.FailedAt = raw.Time
+	}
+	if editedAt, err := time.Parse(time.RFC3339Nano, raw.Header.Get(HeaderDLQEditedAt)); err == nil {
+		letter.EditedAt = &editedAt
+	}
+	return letter
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Envelope is the wire format of every event published on the bus
+type Envelope struct {
+	Metadata EventMetadata   `json:"metadata"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// encode wraps an event in an envelope, taking the correlation and session
+// IDs from the context and the schema version from the event's schema
+func encode(ctx context.Context, subject string, event interface{}) (EventMetadata, []byte, error) {
+	metadata := EventMetadata{
+		EventID:       uuid.New().String(),
+		OccurredAt:    time.Now().UTC(),
+		EventType:     subject,
+		SourceService: "unknown", // This will be set by the service
+	}
+
+	// Add correlation ID and session ID from context if available
+	if id, ok := ctx.Value("correlation_id").(string); ok {
+		metadata.CorrelationID = id
+	}
+	if id, ok := ctx.Value("session_id").(string); ok {
+		metadata.SessionID = id
+	}
+
+	data, version, err := marshalData(event)
+	if err != nil {
+		return metadata, nil, fmt.Errorf("failed to marshal %s event data: %w", subject, err)
+	}
+	metadata.SchemaVersion = version
+
+	payload, err := json.Marshal(Envelope{Metadata: metadata, Data: data})
+	if err != nil {
+		return metadata, nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return metadata, payload, nil
+}
+
+// WithSessionID attaches a session ID that Publish records in the event metadata
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, "session_id", sessionID)
+}
@@ -0,0 +1,361 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// setupTimeout bounds stream and consumer declarations
+const setupTimeout = 10 * time.Second
+
+// Streams returns the JetStream streams the services publish to. Every
+// subject published or subscribed to must be captured by one of them.
+func Streams(cfg *config.Config) []jetstream.StreamConfig {
+	streams := []jetstream.StreamConfig{
+		{
+			Name:        "EKYC_ARTIFACTS",
+			Description: "Verified uploads",
+			Subjects:    []string{"artifact.>"},
+		},
+		{
+			Name:        "EKYC_SESSIONS",
+			Description: "Session status changes",
+			Subjects:    []string{"session.>"},
+		},
+		{
+			Name:        "EKYC_RESULTS",
+			Description: "OCR, face match and liveness results",
+			Subjects:    []string{"ocr.>", "face.>", "liveness.>"},
+		},
+		{
+			Name:        "EKYC_DECISIONS",
+			Description: "Automated and admin decisions",
+			Subjects:    []string{"kyc.>", "admin.>"},
+		},
+		{
+			Name:        "EKYC_AUDIT",
+			Description: "Audit trail",
+			Subjects:    []string{"audit.>"},
+		},
+		{
+			Name:        "MINIO_EVENTS",
+			Description: "Bucket notifications published by MinIO",
+			Subjects:    []string{cfg.MinIONotifySubject},
+		},
+		{
+			Name:        DLQStream,
+			Description: "Events dead-lettered after exhausting their retries",
+			Subjects:    []string{DLQSubjectPrefix + ">"},
+		},
+	}
+
+	for i := range streams {
+		streams[i].Storage = jetstream.FileStorage
+		streams[i].Retention = jetstream.LimitsPolicy
+		streams[i].MaxAge = cfg.EventsStreamMaxAge
+		streams[i].Replicas = cfg.EventsStreamReplicas
+		streams[i].Duplicates = 2 * time.Minute
+		if streams[i].Name == DLQStream {
+			streams[i].MaxAge = cfg.EventsDLQMaxAge
+		}
+	}
+
+	return streams
+}
+
+// JetStreamEventBus is an EventBus backed by JetStream. Publish waits for the
+// stream to store the event, and every subscription is a durable pull
+// consumer: an event is acknowledged only once its handler succeeds, so it
+// survives handler failures and restarts.
+//
+// A failed event is redelivered according to the subscription's
+// RetryPolicy. Once its attempts are exhausted, or at once for errors marked
+// Permanent, it is moved to the DLQ stream with the failure reason, attempt
+// count and original headers. Handlers must therefore be idempotent.
+type JetStreamEventBus struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	logger *logger.Logger
+
+	ackWait time.Duration
+	retry   RetryPolicy
+
+	mu        sync.Mutex
+	consumers []jetstream.ConsumeContext
+}
+
+// NewJetStreamEventBus connects to NATS and declares the event streams
+func NewJetStreamEventBus(cfg *config.Config, logger *logger.Logger) (*JetStreamEventBus, error) {
+	if cfg.EventsAckWait < time.Second {
+		return nil, fmt.Errorf("events ack wait must be at least 1s")
+	}
+	retry := DefaultRetryPolicy(cfg)
+	if err := retry.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid events retry policy: %w", err)
+	}
+
+	conn, err := nats.Connect(cfg.GetNATSAddr(), nats.Name(cfg.ServiceName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), setupTimeout)
+	defer cancel()
+
+	for _, stream := range Streams(cfg) {
+		if _, err := js.CreateOrUpdateStream(ctx, stream); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to declare stream %s: %w", stream.Name, err)
+		}
+	}
+
+	return &JetStreamEventBus{
+		conn:    conn,
+		js:      js,
+		logger:  logger,
+		ackWait: cfg.EventsAckWait,
+		retry:   retry,
+	}, nil
+}
+
+// Publish stores an event in the stream capturing its subject. The event ID
+// is sent as the message ID, so a retried publish is stored only once.
+func (b *JetStreamEventBus) Publish(ctx context.Context, subject string, event interface{}) (err error) {
+	metadata, payload, err := encode(ctx, subject, event)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Data = payload
+	msg.Header.Set(nats.MsgIdHdr, metadata.EventID)
+
+	ctx, span := startPublish(ctx, msg, metadata)
+	defer func() { endSpan(span, err) }()
+
+	return b.publishMsg(ctx, msg)
+}
+
+// publishMsg stores a prepared message, whose headers already carry the
+// message ID and trace context
+func (b *JetStreamEventBus) publishMsg(ctx context.Context, msg *nats.Msg) error {
+	ack, err := b.js.PublishMsg(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("failed to publish %s to JetStream: %w", msg.Subject, err)
+	}
+
+	b.logger.WithContext(ctx).
+		WithCorrelationID(msg.Header.Get(HeaderCorrelationID)).
+		WithSessionID(msg.Header.Get(HeaderSessionID)).
+		Info("Event published",
+			zap.String("subject", msg.Subject),
+			zap.String("event_id", msg.Header.Get(nats.MsgIdHdr)),
+			zap.String("stream", ack.Stream),
+			zap.Uint64("sequence", ack.Sequence),
+			zap.Bool("duplicate", ack.Duplicate),
+		)
+
+	return nil
+}
+
+// subscription is a handler consuming one subject through a durable consumer
+type subscription struct {
+	subject string
+	durable string
+	stream  string
+	retry   RetryPolicy
+	handler EventHandler
+	log     *logger.Logger
+}
+
+// Subscribe consumes subject through a durable consumer shared by every
+// instance in queueGroup. The consumer is created on first use and keeps
+// its position across restarts. Failed events are retried according to the
+// bus's default retry policy unless WithRetryPolicy is given.
+func (b *JetStreamEventBus) Subscribe(ctx context.Context, subject string, queueGroup string, handler EventHandler, opts ...SubscribeOption) error {
+	var options subscribeOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	retry := b.retry
+	if options.retry != nil {
+		retry = *options.retry
+		if err := retry.Validate(); err != nil {
+			return fmt.Errorf("invalid retry policy for %s: %w", subject, err)
+		}
+	}
+
+	setupCtx, cancel := context.WithTimeout(ctx, setupTimeout)
+	defer cancel()
+
+	stream, err := b.js.StreamNameBySubject(setupCtx, subject)
+	if err != nil {
+		return fmt.Errorf("no stream captures %s: %w", subject, err)
+	}
+
+	// Deliveries are not capped by the server: the bus dead-letters an event
+	// itself, so an event whose handler never returns is not silently lost
+	durable := durableName(queueGroup, subject)
+	consumer, err := b.js.CreateOrUpdateConsumer(setupCtx, stream, jetstream.ConsumerConfig{
+		Durable:       durable,
+		FilterSubject: subject,
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       b.ackWait,
+		MaxDeliver:    -1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to declare consumer %s: %w", durable, err)
+	}
+
+	sub := &subscription{
+		subject: subject,
+		durable: durable,
+		stream:  stream,
+		retry:   retry,
+		handler: handler,
+		log:     b.logger.WithFields(zap.String("subject", subject), zap.String("consumer", durable)),
+	}
+	consumeCtx, err := consumer.Consume(
+		func(msg jetstream.Msg) {
+			b.handle(msg, sub)
+		},
+		jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+			sub.log.Warn("JetStream consumer error", zap.Error(err))
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to consume %s: %w", durable, err)
+	}
+
+	b.mu.Lock()
+	b.consumers = append(b.consumers, consumeCtx)
+	b.mu.Unlock()
+
+	sub.log.Info("Subscribed to JetStream subject",
+		zap.String("stream", stream),
+		zap.Int("max_attempts", retry.MaxAttempts),
+	)
+
+	return nil
+}
+
+// handle runs the handler for one delivery and settles the message: ack on
+// success, dead-letter on a permanent error or the last attempt, and nak
+// with a backoff delay otherwise
+func (b *JetStreamEventBus) handle(msg jetstream.Msg, sub *subscription) {
+	ctx, span := startConsume(msg.Subject(), msg.Headers())
+
+	attempt := 1
+	var sequence uint64
+	if meta, err := msg.Metadata(); err == nil {
+		attempt = int(meta.NumDelivered)
+		sequence = meta.Sequence.Stream
+	}
+	span.SetAttributes(attribute.Int("messaging.nats.delivery_attempt", attempt))
+	log := sub.log.WithContext(ctx).WithFields(zap.Int("attempt", attempt))
+
+	// A delivery past the last attempt had no result, e.g. the handler kept
+	// outliving the ack wait or dead-lettering failed
+	if attempt > sub.retry.MaxAttempts {
+		err := fmt.Errorf("no successful delivery in %d attempts", sub.retry.MaxAttempts)
+		endSpan(span, err)
+		b.deadLetter(ctx, msg, sub, sequence, attempt-1, err, log)
+		return
+	}
+
+	// Keep the delivery alive while the handler runs past the ack wait
+	done := make(chan struct{})
+	go b.keepAlive(msg, done)
+	err := sub.handler(ctx, msg.Data())
+	close(done)
+	endSpan(span, err)
+
+	switch {
+	case err == nil:
+		if ackErr := msg.Ack(); ackErr != nil {
+			log.Warn("Failed to acknowledge event", zap.Error(ackErr))
+		}
+	case IsPermanent(err), attempt >= sub.retry.MaxAttempts:
+		b.deadLetter(ctx, msg, sub, sequence, attempt, err, log)
+	default:
+		delay := sub.retry.Backoff(attempt)
+		log.Warn("Event failed, redelivering", zap.Duration("delay", delay), zap.Error(err))
+		if nakErr := msg.NakWithDelay(delay); nakErr != nil {
+			log.Warn("Failed to nak event", zap.Error(nakErr))
+		}
+	}
+}
+
+// deadLetter moves a failed event to the DLQ and terminates its delivery.
+// If the DLQ cannot store it, the event is redelivered after the maximum
+// delay so that it is dead-lettered on a later attempt instead of lost.
+func (b *JetStreamEventBus) deadLetter(ctx context.Context, msg jetstream.Msg, sub *subscription, sequence uint64, attempts int, reason error, log *logger.Logger) {
+	dlq := deadLetterMsg(msg.Subject(), msg.Headers(), msg.Data(), sub.durable, sub.stream, sequence, attempts, reason)
+	if _, err := b.js.PublishMsg(ctx, dlq); err != nil {
+		log.Error("Failed to dead-letter event", zap.NamedError("reason", reason), zap.Error(err))
+		if nakErr := msg.NakWithDelay(sub.retry.MaxDelay); nakErr != nil {
+			log.Warn("Failed to nak event", zap.Error(nakErr))
+		}
+		return
+	}
+
+	log.Error("Event dead-lettered", zap.String("dlq_subject", dlq.Subject), zap.Error(reason))
+	if termErr := msg.TermWithReason("dead-lettered: " + reason.Error()); termErr != nil {
+		log.Warn("Failed to terminate event", zap.Error(termErr))
+	}
+}
+
+// keepAlive marks a delivery as in progress every half ack wait until done
+// is closed
+func (b *JetStreamEventBus) keepAlive(msg jetstream.Msg, done <-chan struct{}) {
+	ticker := time.NewTicker(b.ackWait / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			msg.InProgress()
+		}
+	}
+}
+
+// Close stops every consumer, letting in-flight handlers finish, and drains
+// the connection
+func (b *JetStreamEventBus) Close() error {
+	b.mu.Lock()
+	for _, consumer := range b.consumers {
+		consumer.Drain()
+	}
+	b.consumers = nil
+	b.mu.Unlock()
+
+	if b.conn != nil {
+		return b.conn.Drain()
+	}
+	return nil
+}
+
+// durableName derives a consumer name from a queue group and subject.
+// Consumer names cannot contain '.', '*', '>' or whitespace.
+func durableName(queueGroup, subject string) string {
+	return strings.NewReplacer(".", "_", "*", "any", ">", "all", " ", "_").Replace(queueGroup + "-" + subject)
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/ekyc-backend/pkg/logger"
+)
+
+// fakeJetStream records the messages published through it
+type fakeJetStream struct {
+	jetstream.JetStream
+	published []*nats.Msg
+}
+
+func (js *fakeJetStream) PublishMsg(_ context.Context, msg *nats.Msg, _ ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	js.published = append(js.published, msg)
+	return &jetstream.PubAck{Stream: DLQStream}, nil
+}
+
+// fakeMsg is one delivery of an event and records how it was settled
+type fakeMsg struct {
+	jetstream.Msg
+	subject   string
+	header    nats.Header
+	data      []byte
+	delivered uint64
+	sequence  uint64
+
+	acked      bool
+	nakDelay   time.Duration
+	termReason string
+}
+
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{
+		Sequence:     jetstream.SequencePair{Stream: m.sequence},
+		NumDelivered: m.delivered,
+	}, nil
+}
+
+func (m *fakeMsg) Subject() string      { return m.subject }
+func (m *fakeMsg) Headers() nats.Header { return m.header }
+func (m *fakeMsg) Data() []byte         { return m.data }
+func (m *fakeMsg) InProgress() error    { return nil }
+
+func (m *fakeMsg) Ack() error {
+	m.acked = true
+	return nil
+}
+
+func (m *fakeMsg) NakWithDelay(delay time.Duration) error {
+	m.nakDelay = delay
+	return nil
+}
+
+func (m *fakeMsg) TermWithReason(reason string) error {
+	m.termReason = reason
+	return nil
+}
+
+func TestHandleDeadLettersAfterMaxAttempts(t *testing.T) {
+	const subject = "ekyc.artifact.uploaded"
+	policy := RetryPolicy{MaxAttempts: 3, InitialDelay: time.Second, MaxDelay: 4 * time.Second}
+	failure := errors.New("analyzer unavailable")
+
+	tests := []struct {
+		name         string
+		delivered    uint64
+		handlerErr   error
+		wantCalled   bool
+		wantNak      time.Duration
+		wantAttempts int
+		wantReason   string
+	}{
+		{name: "retried before the last attempt", delivered: 2, handlerErr: failure, wantCalled: true, wantNak: 2 * time.Second},
+		{name: "failed on the last attempt", delivered: 3, handlerErr: failure, wantCalled: true, wantAttempts: 3, wantReason: failure.Error()},
+		{name: "delivered past the last attempt", delivered: 4, wantAttempts: 3, wantReason: "no successful delivery in 3 attempts"},
+		{name: "permanent failure", delivered: 1, handlerErr: Permanent(failure), wantCalled: true, wantAttempts: 1, wantReason: failure.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := &fakeJetStream{}
+			bus := &JetStreamEventBus{js: js, logger: logger.New("test"), ackWait: time.Minute}
+
+			var options subscribeOptions
+			WithRetryPolicy(policy)(&options)
+			called := false
+			sub := &subscription{
+				subject: subject,
+				durable: durableName("liveness", subject),
+				stream:  "EKYC",
+				retry:   *options.retry,
+				handler: func(context.Context, []byte) error {
+					called = true
+					return tt.handlerErr
+				},
+				log: bus.logger,
+			}
+
+			msg := &fakeMsg{
+				subject:   subject,
+				header:    nats.Header{"Traceparent": []string{"00-trace-span-01"}, "X-Session-Id": []string{"session-1"}},
+				data:      []byte(`{"session_id":"session-1"}`),
+				delivered: tt.delivered,
+				sequence:  42,
+			}
+			bus.handle(msg, sub)
+
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if msg.acked {
+				t.Errorf("failed event was acknowledged")
+			}
+
+			if tt.wantReason == "" {
+				if msg.nakDelay != tt.wantNak {
+					t.Errorf("nak delay = %v, want %v", msg.nakDelay, tt.wantNak)
+				}
+				if len(js.published) != 0 || msg.termReason != "" {
+					t.Errorf("event retried on attempt %d was dead-lettered", tt.delivered)
+				}
+				return
+			}
+
+			if len(js.published) != 1 {
+				t.Fatalf("published %d messages, want 1 dead letter", len(js.published))
+			}
+			dlq := js.published[0]
+			if dlq.Subject != DLQSubjectPrefix+subject {
+				t.Errorf("dead letter subject = %q, want %q", dlq.Subject, DLQSubjectPrefix+subject)
+			}
+			if string(dlq.Data) != string(msg.data) {
+				t.Errorf("dead letter data = %s, want %s", dlq.Data, msg.data)
+			}
+			if got := dlq.Header.Get(HeaderDLQReason); got != tt.wantReason {
+				t.Errorf("%s = %q, want %q", HeaderDLQReason, got, tt.wantReason)
+			}
+			if got := dlq.Header.Get(HeaderDLQAttempts); got != strconv.Itoa(tt.wantAttempts) {
+				t.Errorf("%s = %q, want %d", HeaderDLQAttempts, got, tt.wantAttempts)
+			}
+			if got := dlq.Header.Get(HeaderDLQSubject); got != subject {
+				t.Errorf("%s = %q, want %q", HeaderDLQSubject, got, subject)
+			}
+			if got := dlq.Header.Get(HeaderDLQSequence); got != "42" {
+				t.Errorf("%s = %q, want 42", HeaderDLQSequence, got)
+			}
+			for key, values := range msg.header {
+				if got := dlq.Header.Get(key); got != values[0] {
+					t.Errorf("original header %s = %q, want %q", key, got, values[0])
+				}
+			}
+			if msg.termReason != "dead-lettered: "+tt.wantReason {
+				t.Errorf("term reason = %q, want the dead-letter reason", msg.termReason)
+			}
+			if msg.nakDelay != 0 {
+				t.Errorf("dead-lettered event was also nak'd after %v", msg.nakDelay)
+			}
+		})
+	}
+}
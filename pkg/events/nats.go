@@ -2,20 +2,18 @@ package events
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/ekyc-backend/pkg/logger"
 	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 type EventBus interface {
 	Publish(ctx context.Context, subject string, event interface{}) error
-	Subscribe(ctx context.Context, subject string, queueGroup string, handler EventHandler) error
+	Subscribe(ctx context.Context, subject string, queueGroup string, handler EventHandler, opts ...SubscribeOption) error
 	Close() error
 }
 
@@ -33,6 +31,7 @@ type EventMetadata struct {
 	OccurredAt    time.Time `json:"occurred_at"`
 	EventType     string    `json:"event_type"`
 	SourceService string    `json:"source_service"`
+	SchemaVersion int       `json:"schema_version,omitempty"`
 }
 
 func NewNATSEventBus(natsURL string, logger *logger.Logger) (*NATSEventBus, error) {
@@ -47,65 +46,25 @@ func NewNATSEventBus(natsURL string, logger *logger.Logger) (*NATSEventBus, erro
 	}, nil
 }
 
-func (n *NATSEventBus) Publish(ctx context.Context, subject string, event interface{}) error {
-	span := trace.SpanFromContext(ctx)
-	defer span.End()
-
-	// Create event metadata
-	metadata := EventMetadata{
-		EventID:       uuid.New().String(),
-		OccurredAt:    time.Now().UTC(),
-		EventType:     subject,
-		SourceService: "unknown", // This will be set by the service
-	}
-
-	// Add correlation ID and session ID from context if available
-	if correlationID := ctx.Value("correlation_id"); correlationID != nil {
-		if id, ok := correlationID.(string); ok {
-			metadata.CorrelationID = id
-		}
-	}
-
-	if sessionID := ctx.Value("session_id"); sessionID != nil {
-		if id, ok := sessionID.(string); ok {
-			metadata.SessionID = id
-		}
-	}
-
-	// Create envelope with metadata and event
-	envelope := map[string]interface{}{
-		"metadata": metadata,
-		"data":     event,
-	}
-
-	// Serialize envelope
-	payload, err := json.Marshal(envelope)
+func (n *NATSEventBus) Publish(ctx context.Context, subject string, event interface{}) (err error) {
+	metadata, payload, err := encode(ctx, subject, event)
 	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
+		return err
 	}
 
-	// Add tracing attributes
-	span.SetAttributes(
-		attribute.String("nats.subject", subject),
-		attribute.String("nats.event_id", metadata.EventID),
-		attribute.String("nats.correlation_id", metadata.CorrelationID),
-		attribute.String("nats.session_id", metadata.SessionID),
-	)
+	msg := nats.NewMsg(subject)
+	msg.Data = payload
+
+	// Start a producer span and carry its context in the headers
+	ctx, span := startPublish(ctx, msg, metadata)
+	defer func() { endSpan(span, err) }()
 
 	// Publish to NATS
-	if err := n.conn.Publish(subject, payload); err != nil {
-		span.RecordError(err)
+	if err := n.conn.PublishMsg(msg); err != nil {
 		return fmt.Errorf("failed to publish to NATS: %w", err)
 	}
 
-	// Publish to DLQ if there's an error (simulated)
-	if metadata.EventType == "error" {
-		dlqSubject := subject + ".dlq"
-		n.conn.Publish(dlqSubject, payload)
-	}
-
-	n.logger.WithContext(ctx).WithTraceID(span.SpanContext().TraceID().String()).
-		WithSpanID(span.SpanContext().SpanID().String()).
+	n.logger.WithContext(ctx).
 		WithCorrelationID(metadata.CorrelationID).
 		WithSessionID(metadata.SessionID).
 		Info("Event published")
@@ -113,33 +72,24 @@ func (n *NATSEventBus) Publish(ctx context.Context, subject string, event interf
 	return nil
 }
 
-func (n *NATSEventBus) Subscribe(ctx context.Context, subject string, queueGroup string, handler EventHandler) error {
-	span := trace.SpanFromContext(ctx)
-	defer span.End()
-
+// Subscribe consumes subject with a core NATS queue subscription. Core NATS
+// cannot redeliver, so retry options are ignored and a failed event is
+// dead-lettered after its single attempt.
+func (n *NATSEventBus) Subscribe(ctx context.Context, subject string, queueGroup string, handler EventHandler, opts ...SubscribeOption) error {
 	// Subscribe with queue group for load balancing
 	subscription, err := n.conn.QueueSubscribe(subject, queueGroup, func(msg *nats.Msg) {
-		// Create new context for each message
-		msgCtx := context.Background()
-
-		// Extract correlation ID and session ID from message headers if available
-		if msg.Header != nil {
-			if correlationID := msg.Header.Get("X-Correlation-ID"); correlationID != "" {
-				msgCtx = context.WithValue(msgCtx, "correlation_id", correlationID)
-			}
-			if sessionID := msg.Header.Get("X-Session-ID"); sessionID != "" {
-				msgCtx = context.WithValue(msgCtx, "session_id", sessionID)
-			}
-		}
-
-		// Create span for message processing
-		msgSpan := trace.SpanFromContext(msgCtx)
-		defer msgSpan.End()
+		// Continue the producer's trace with correlation and session IDs from the headers
+		msgCtx, msgSpan := startConsume(msg.Subject, msg.Header)
 
 		// Process message
-		if err := handler(msgCtx, msg.Data); err != nil {
-			msgSpan.RecordError(err)
-			n.logger.WithContext(msgCtx).Error("Failed to process message")
+		err := handler(msgCtx, msg.Data)
+		endSpan(msgSpan, err)
+		if err != nil {
+			n.logger.WithContext(msgCtx).Error("Failed to process message", zap.Error(err))
+			dlq := deadLetterMsg(msg.Subject, msg.Header, msg.Data, queueGroup, "", 0, 1, err)
+			if dlqErr := n.conn.PublishMsg(dlq); dlqErr != nil {
+				n.logger.WithContext(msgCtx).Error("Failed to dead-letter message", zap.Error(dlqErr))
+			}
 		}
 
 		// Acknowledge message
@@ -147,7 +97,6 @@ func (n *NATSEventBus) Subscribe(ctx context.Context, subject string, queueGroup
 	})
 
 	if err != nil {
-		span.RecordError(err)
 		return fmt.Errorf("failed to subscribe to NATS: %w", err)
 	}
 
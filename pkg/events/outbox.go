@@ -0,0 +1,268 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// outboxLockKey is the advisory lock held by the relay publishing the outbox.
+// Only the replica holding it publishes, which keeps events in order.
+const outboxLockKey int64 = 0x656b79632d6f7574 // "ekyc-out"
+
+// outboxPruneInterval is how often the relay deletes expired sent rows
+const outboxPruneInterval = time.Hour
+
+// enqueue writes an event to the outbox inside tx, to be published by the
+// OutboxRelay once tx commits. The event is published only if tx commits,
+// so it cannot disagree with the state change written alongside it.
+//
+// The trace context, baggage, correlation ID and session ID are taken from
+// ctx as Publish would, so consumers continue the trace that enqueued it.
+func enqueue(ctx context.Context, tx pgx.Tx, subject string, event interface{}) (err error) {
+	metadata, payload, err := encode(ctx, subject, event)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(subject)
+	ctx, span := startPublish(ctx, msg, metadata)
+	defer func() { endSpan(span, err) }()
+
+	headers, err := json.Marshal(msg.Header)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event headers: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO outbox (event_id, subject, payload, headers)
+		VALUES ($1, $2, $3, $4)`,
+		metadata.EventID, subject, payload, headers,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write %s to the outbox: %w", subject, err)
+	}
+
+	return nil
+}
+
+// outboxEntry is a pending outbox row
+type outboxEntry struct {
+	id       int64
+	eventID  string
+	subject  string
+	payload  []byte
+	headers  nats.Header
+	attempts int
+	due      bool
+}
+
+// OutboxRelay publishes the events written with Enqueue to JetStream, in the
+// order they were committed, and marks them sent.
+//
+// Rows are ordered by the ID of the transaction that wrote them and then by
+// row id. A row is only published once its transaction is older than the
+// oldest transaction still in progress (the xmin of the relay's snapshot), so
+// a transaction that commits late cannot slip an event in behind events
+// already sent. A long-running transaction anywhere in the database holds
+// the outbox back until it ends.
+//
+// Events keep their order within a transaction, and transactions are ordered
+// by when they first wrote. Identity locks the session row before writing,
+// so the status changes of one session are published in the order they were
+// made. No other order between transactions is guaranteed.
+//
+// Every replica may run a relay: each batch is read under a transaction-level
+// advisory lock, so one relay publishes at a time and the others skip the
+// cycle. A failed publish is retried after a delay that doubles with each
+// attempt, and later events wait behind it so that order is kept. Delivery
+// is at least once: the event ID is sent as the message ID, so JetStream
+// drops an event republished within its duplicate window, and consumers
+// must be idempotent beyond it.
+type OutboxRelay struct {
+	db     *db.DB
+	bus    *JetStreamEventBus
+	logger *logger.Logger
+
+	pollInterval time.Duration
+	batchSize    int
+	retention    time.Duration
+	retry        RetryPolicy
+}
+
+// NewOutboxRelay creates a relay publishing the outbox in database to bus
+func NewOutboxRelay(database *db.DB, bus *JetStreamEventBus, cfg *config.Config, logger *logger.Logger) (*OutboxRelay, error) {
+	if cfg.EventsOutboxPollInterval <= 0 {
+		return nil, fmt.Errorf("events outbox poll interval must be positive")
+	}
+	if cfg.EventsOutboxBatchSize <= 0 {
+		return nil, fmt.Errorf("events outbox batch size must be positive")
+	}
+
+	return &OutboxRelay{
+		db:           database,
+		bus:          bus,
+		logger:       logger.WithFields(zap.String("component", "outbox_relay")),
+		pollInterval: cfg.EventsOutboxPollInterval,
+		batchSize:    cfg.EventsOutboxBatchSize,
+		retention:    cfg.EventsOutboxRetention,
+		retry:        DefaultRetryPolicy(cfg),
+	}, nil
+}
+
+// Run publishes the outbox until ctx is cancelled. A full batch is followed
+// by the next one at once; otherwise the relay waits for the poll interval.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay started", zap.Duration("poll_interval", r.pollInterval))
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	var lastPrune time.Time
+	for {
+		sent, err := r.relay(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Error("Failed to relay outbox", zap.Error(err))
+		}
+
+		if r.retention > 0 && time.Since(lastPrune) >= outboxPruneInterval {
+			if err := r.prune(ctx); err != nil && ctx.Err() == nil {
+				r.logger.Warn("Failed to prune outbox", zap.Error(err))
+			}
+			lastPrune = time.Now()
+		}
+
+		if sent == r.batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay publishes one batch of pending events in commit order and returns
+// how many were sent. It stops at the first event that is not due or fails to
+// publish, so no event overtakes an earlier one.
+func (r *OutboxRelay) relay(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, outboxLockKey).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("failed to take outbox lock: %w", err)
+	}
+	if !locked {
+		// Another replica is relaying
+		return 0, nil
+	}
+
+	pending, err := r.pending(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, entry := range pending {
+		if !entry.due {
+			break
+		}
+
+		msg := nats.NewMsg(entry.subject)
+		msg.Data = entry.payload
+		msg.Header = entry.headers
+		msg.Header.Set(nats.MsgIdHdr, entry.eventID)
+
+		if err := r.bus.publishMsg(ctx, msg); err != nil {
+			delay := r.retry.Backoff(entry.attempts + 1)
+			r.logger.Warn("Outbox event failed, retrying",
+				zap.String("subject", entry.subject),
+				zap.String("event_id", entry.eventID),
+				zap.Int("attempt", entry.attempts+1),
+				zap.Duration("delay", delay),
+				zap.Error(err),
+			)
+			_, err = tx.Exec(ctx, `
+				UPDATE outbox
+				SET attempts = attempts + 1, last_error = $2, next_attempt_at = NOW() + make_interval(secs => $3)
+				WHERE id = $1`,
+				entry.id, err.Error(), delay.Seconds(),
+			)
+			if err != nil {
+				return sent, fmt.Errorf("failed to record outbox failure: %w", err)
+			}
+			break
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE outbox SET attempts = attempts + 1, sent_at = NOW() WHERE id = $1`, entry.id)
+		if err != nil {
+			return sent, fmt.Errorf("failed to mark outbox event sent: %w", err)
+		}
+		sent++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit outbox batch: %w", err)
+	}
+
+	return sent, nil
+}
+
+// pending loads the next batch of unsent events whose transactions are
+// settled: every transaction older than the snapshot's xmin has committed or
+// rolled back, so no further row can appear before the ones returned
+func (r *OutboxRelay) pending(ctx context.Context, tx pgx.Tx) ([]outboxEntry, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT id, event_id::text, subject, payload, headers, attempts, next_attempt_at <= NOW()
+		FROM outbox
+		WHERE sent_at IS NULL AND txid < pg_snapshot_xmin(pg_current_snapshot())
+		ORDER BY txid, id
+		LIMIT $1`,
+		r.batchSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []outboxEntry
+	for rows.Next() {
+		var (
+			entry   outboxEntry
+			headers []byte
+		)
+		if err := rows.Scan(&entry.id, &entry.eventID, &entry.subject, &entry.payload, &headers, &entry.attempts, &entry.due); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		entry.headers = nats.Header{}
+		if err := json.Unmarshal(headers, &entry.headers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal headers of outbox event %s: %w", entry.eventID, err)
+		}
+		pending = append(pending, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	return pending, nil
+}
+
+// prune deletes events sent longer ago than the retention period
+func (r *OutboxRelay) prune(ctx context.Context) error {
+	return r.db.Exec(ctx, `DELETE FROM outbox WHERE sent_at < NOW() - make_interval(secs => $1)`, r.retention.Seconds())
+}
@@ -0,0 +1,29 @@
+package events
+
+import (
+	"github.com/ekyc-backend/pkg/reasons"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// SubjectOCRCompleted is published once doc-ocr has processed a document
+// image, with a proto.OcrCompleted. The extracted personal data is not part
+// of the event; it is kept encrypted in person_pii.
+const SubjectOCRCompleted = "ocr.completed"
+
+// SubjectFaceCompleted is published once face-match has compared a session's
+// document photo with its selfie, with a proto.FaceMatched
+const SubjectFaceCompleted = "face.completed"
+
+// SubjectLivenessCompleted is published once the liveness service has
+// analyzed a liveness clip or selfie, with a proto.LivenessCompleted
+const SubjectLivenessCompleted = "liveness.completed"
+
+// ProtoReasons converts the findings of a check to their event form
+func ProtoReasons(found []reasons.Reason) []*proto.Reason {
+	out := make([]*proto.Reason, 0, len(found))
+	for _, r := range found {
+		out = append(out, &proto.Reason{Code: r.Code, Field: r.Field, Message: r.Message})
+	}
+	return out
+}
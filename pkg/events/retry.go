@@ -0,0 +1,63 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+)
+
+// RetryPolicy controls how a subscription retries a failed event. The event
+// is redelivered after InitialDelay, doubling with each attempt up to
+// MaxDelay, and dead-lettered once MaxAttempts deliveries have failed.
+type RetryPolicy struct {
+	MaxAttempts  int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy configured by EVENTS_MAX_DELIVER,
+// EVENTS_RETRY_DELAY and EVENTS_MAX_RETRY_DELAY
+func DefaultRetryPolicy(cfg *config.Config) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:  cfg.EventsMaxDeliver,
+		InitialDelay: cfg.EventsRetryDelay,
+		MaxDelay:     cfg.EventsMaxRetryDelay,
+	}
+}
+
+// Validate checks that the policy allows at least one attempt and that its
+// delays are ordered
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("retry policy must allow at least one attempt")
+	}
+	if p.InitialDelay <= 0 || p.MaxDelay < p.InitialDelay {
+		return fmt.Errorf("retry policy delays must satisfy 0 < initial delay <= max delay")
+	}
+	return nil
+}
+
+// Backoff returns the delay before retrying after a failed attempt
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// subscribeOptions holds the options of one subscription
+type subscribeOptions struct {
+	retry *RetryPolicy
+}
+
+// SubscribeOption configures a subscription
+type SubscribeOption func(*subscribeOptions)
+
+// WithRetryPolicy replaces the bus's default retry policy for a subscription
+func WithRetryPolicy(policy RetryPolicy) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.retry = &policy
+	}
+}
@@ -0,0 +1,272 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// Schema describes the proto message published on a subject
+type Schema struct {
+	Subject string
+	// Version is the schema version producers write. Events without a
+	// version predate versioning and are read as version 1.
+	Version int
+	// Required lists proto field names that must be set
+	Required []string
+}
+
+// schemas maps every event message to its subject and current version
+var schemas = map[protoreflect.FullName]Schema{
+	fullName[*proto.ArtifactUploaded](): {
+		Subject:  SubjectArtifactUploaded,
+		Version:  1,
+		Required: []string{"artifact_id", "session_id", "artifact_type", "object_key", "uploaded_at"},
+	},
+	fullName[*proto.OcrCompleted](): {
+		Subject:  SubjectOCRCompleted,
+		Version:  1,
+		Required: []string{"result_id", "session_id", "artifact_id", "completed_at"},
+	},
+	fullName[*proto.FaceMatched](): {
+		Subject:  SubjectFaceCompleted,
+		Version:  1,
+		Required: []string{"result_id", "session_id", "selfie_artifact_id", "completed_at"},
+	},
+	fullName[*proto.LivenessCompleted](): {
+		Subject:  SubjectLivenessCompleted,
+		Version:  1,
+		Required: []string{"result_id", "session_id", "artifact_id", "mode", "completed_at"},
+	},
+	fullName[*proto.SessionStatusChanged](): {
+		Subject:  SubjectSessionStatusChanged,
+		Version:  1,
+		Required: []string{"session_id", "status", "changed_at"},
+	},
+	fullName[*proto.SessionScored](): {
+		Subject:  SubjectSessionScored,
+		Version:  1,
+		Required: []string{"decision_id", "session_id", "decision", "ruleset_version", "scored_at"},
+	},
+	fullName[*proto.DecisionApplied](): {
+		Subject:  SubjectDecisionApplied,
+		Version:  1,
+		Required: []string{"decision_id", "session_id", "decision", "decided_by", "decided_at"},
+	},
+}
+
+// Upcaster rewrites the JSON data of an event from one schema version to the
+// next. It receives data in the layout of version v and returns it in the
+// layout of version v+1.
+type Upcaster func(data json.RawMessage) (json.RawMessage, error)
+
+var (
+	upcastersMu sync.RWMutex
+	upcasters   = map[string]map[int]Upcaster{}
+)
+
+// RegisterUpcaster registers the upcaster from version from to from+1 of
+// the event published on subject. Register one whenever a schema version is
+// bumped, so that consumers keep reading events written before the bump,
+// including those waiting in streams and the DLQ.
+func RegisterUpcaster(subject string, from int, upcaster Upcaster) {
+	upcastersMu.Lock()
+	defer upcastersMu.Unlock()
+
+	if upcasters[subject] == nil {
+		upcasters[subject] = map[int]Upcaster{}
+	}
+	upcasters[subject][from] = upcaster
+}
+
+// RenameField returns an upcaster for a version that renamed a field of the
+// event data from one JSON name to another. Data without the old field is
+// returned unchanged.
+//
+//	events.RegisterUpcaster(events.SubjectArtifactUploaded, 1, events.RenameField("key", "object_key"))
+func RenameField(from, to string) Upcaster {
+	return func(data json.RawMessage) (json.RawMessage, error) {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		value, ok := fields[from]
+		if !ok {
+			return data, nil
+		}
+		if _, taken := fields[to]; taken {
+			return nil, fmt.Errorf("data has both %s and %s", from, to)
+		}
+		delete(fields, from)
+		fields[to] = value
+		return json.Marshal(fields)
+	}
+}
+
+// SchemaOf returns the schema of an event message
+func SchemaOf(event protoreflect.ProtoMessage) (Schema, error) {
+	name := event.ProtoReflect().Descriptor().FullName()
+	schema, ok := schemas[name]
+	if !ok {
+		return Schema{}, fmt.Errorf("%s is not an event schema", name)
+	}
+	return schema, nil
+}
+
+// Publish validates a typed event and publishes it on the subject of its
+// schema
+func Publish[T protoreflect.ProtoMessage](ctx context.Context, bus EventBus, event T) error {
+	schema, err := SchemaOf(event)
+	if err != nil {
+		return err
+	}
+	if err := validate(event, schema); err != nil {
+		return err
+	}
+	return bus.Publish(ctx, schema.Subject, event)
+}
+
+// Enqueue validates a typed event and writes it to the outbox inside tx, to
+// be published on the subject of its schema by the OutboxRelay once tx
+// commits
+func Enqueue[T protoreflect.ProtoMessage](ctx context.Context, tx pgx.Tx, event T) error {
+	schema, err := SchemaOf(event)
+	if err != nil {
+		return err
+	}
+	if err := validate(event, schema); err != nil {
+		return err
+	}
+	return enqueue(ctx, tx, schema.Subject, event)
+}
+
+// TypedHandler processes a decoded, validated event
+type TypedHandler[T protoreflect.ProtoMessage] func(ctx context.Context, event T) error
+
+// Subscribe consumes the subject of T's schema, decoding every event into a
+// new T after upcasting it to the current schema version. Events that do not
+// decode or validate fail permanently and are dead-lettered.
+func Subscribe[T protoreflect.ProtoMessage](ctx context.Context, bus EventBus, queueGroup string, handler TypedHandler[T], opts ...SubscribeOption) error {
+	var zero T
+	schema, err := SchemaOf(zero)
+	if err != nil {
+		return err
+	}
+
+	return bus.Subscribe(ctx, schema.Subject, queueGroup, func(ctx context.Context, payload []byte) error {
+		event := zero.ProtoReflect().Type().New().Interface().(T)
+		if _, err := DecodeEvent(payload, event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	}, opts...)
+}
+
+// DecodeEvent unmarshals an event envelope into a typed event, upcasting
+// older schema versions first. Errors are permanent: an event that cannot be
+// read, or was written by a newer producer, will not decode on redelivery.
+// Such events wait in the DLQ until they are replayed to an upgraded consumer.
+func DecodeEvent(payload []byte, event protoreflect.ProtoMessage) (EventMetadata, error) {
+	schema, err := SchemaOf(event)
+	if err != nil {
+		return EventMetadata{}, Permanent(err)
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return EventMetadata{}, Permanent(fmt.Errorf("failed to unmarshal event envelope: %w", err))
+	}
+	metadata := envelope.Metadata
+	if metadata.EventType != "" && metadata.EventType != schema.Subject {
+		return metadata, Permanent(fmt.Errorf("%s event cannot be read as %s", metadata.EventType, schema.Subject))
+	}
+
+	data, err := upcast(schema, metadata.SchemaVersion, envelope.Data)
+	if err != nil {
+		return metadata, Permanent(err)
+	}
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, event); err != nil {
+		return metadata, Permanent(fmt.Errorf("failed to unmarshal %s event data: %w", schema.Subject, err))
+	}
+	if err := validate(event, schema); err != nil {
+		return metadata, Permanent(err)
+	}
+
+	return metadata, nil
+}
+
+// upcast brings event data from version to the schema's current version
+func upcast(schema Schema, version int, data json.RawMessage) (json.RawMessage, error) {
+	if version == 0 {
+		version = 1
+	}
+	if version > schema.Version {
+		return nil, fmt.Errorf("%s schema version %d is newer than the supported version %d", schema.Subject, version, schema.Version)
+	}
+
+	upcastersMu.RLock()
+	defer upcastersMu.RUnlock()
+
+	for ; version < schema.Version; version++ {
+		upcaster, ok := upcasters[schema.Subject][version]
+		if !ok {
+			return nil, fmt.Errorf("no upcaster for %s from schema version %d", schema.Subject, version)
+		}
+
+		var err error
+		data, err = upcaster(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upcast %s from schema version %d: %w", schema.Subject, version, err)
+		}
+	}
+
+	return data, nil
+}
+
+// validate checks that the required fields of an event are set
+func validate(event protoreflect.ProtoMessage, schema Schema) error {
+	message := event.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for _, name := range schema.Required {
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			return fmt.Errorf("%s schema requires unknown field %s", schema.Subject, name)
+		}
+		if !message.Has(field) {
+			return fmt.Errorf("%s event is missing %s", schema.Subject, name)
+		}
+	}
+	return nil
+}
+
+// marshalData encodes the data of an event. Event messages are written as
+// JSON with proto field names and get the version of their schema; other
+// values are written with encoding/json and have no version.
+func marshalData(event interface{}) (json.RawMessage, int, error) {
+	message, ok := event.(protoreflect.ProtoMessage)
+	if !ok {
+		data, err := json.Marshal(event)
+		return data, 0, err
+	}
+
+	schema, err := SchemaOf(message)
+	if err != nil {
+		return nil, 0, err
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	return data, schema.Version, err
+}
+
+// fullName returns the proto name of a message type
+func fullName[T protoreflect.ProtoMessage]() protoreflect.FullName {
+	var zero T
+	return zero.ProtoReflect().Descriptor().FullName()
+}
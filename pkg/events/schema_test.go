@@ -0,0 +1,185 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// withSchemaVersion bumps the version of an event schema for a test, as a
+// producer release would, and drops the upcasters the test registers
+func withSchemaVersion(t *testing.T, name protoreflect.FullName, version int) Schema {
+	t.Helper()
+
+	schema := schemas[name]
+	bumped := schema
+	bumped.Version = version
+	schemas[name] = bumped
+
+	t.Cleanup(func() {
+		schemas[name] = schema
+		upcastersMu.Lock()
+		delete(upcasters, schema.Subject)
+		upcastersMu.Unlock()
+	})
+	return bumped
+}
+
+// envelope builds the payload of an event with the given schema version
+func envelope(t *testing.T, subject string, version int, data string) []byte {
+	t.Helper()
+
+	payload, err := json.Marshal(Envelope{
+		Metadata: EventMetadata{
+			EventID:       "event-1",
+			OccurredAt:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			EventType:     subject,
+			SchemaVersion: version,
+		},
+		Data: json.RawMessage(data),
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	return payload
+}
+
+const artifactData = `{
+	"artifact_id": "artifact-1",
+	"session_id": "session-1",
+	"artifact_type": "SELFIE",
+	"object_key": "sessions/session-1/selfie.jpg",
+	"uploaded_at": "2026-01-01T00:00:00Z"
+}`
+
+func TestDecodeEvent(t *testing.T) {
+	withoutKey := strings.Replace(artifactData, `"object_key": "sessions/session-1/selfie.jpg",`, "", 1)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		wantErr string
+	}{
+		{name: "current version", payload: envelope(t, SubjectArtifactUploaded, 1, artifactData)},
+		{name: "unversioned event is version 1", payload: envelope(t, SubjectArtifactUploaded, 0, artifactData)},
+		{name: "unknown fields are ignored", payload: envelope(t, SubjectArtifactUploaded, 1, strings.Replace(artifactData, "{", `{"added_later": true,`, 1))},
+		{name: "newer version", payload: envelope(t, SubjectArtifactUploaded, 2, artifactData), wantErr: "newer than the supported version"},
+		{name: "missing required field", payload: envelope(t, SubjectArtifactUploaded, 1, withoutKey), wantErr: "missing object_key"},
+		{name: "other subject", payload: envelope(t, SubjectOCRCompleted, 1, artifactData), wantErr: "cannot be read as"},
+		{name: "malformed envelope", payload: []byte("{"), wantErr: "envelope"},
+		{name: "malformed data", payload: envelope(t, SubjectArtifactUploaded, 1, `{"artifact_id": 1}`), wantErr: "failed to unmarshal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &proto.ArtifactUploaded{}
+			metadata, err := DecodeEvent(tt.payload, event)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DecodeEvent() error = %v, want %q", err, tt.wantErr)
+				}
+				if !IsPermanent(err) {
+					t.Fatalf("DecodeEvent() error = %v, want a permanent error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeEvent() error = %v", err)
+			}
+			if metadata.EventID != "event-1" {
+				t.Fatalf("DecodeEvent() event ID = %q, want event-1", metadata.EventID)
+			}
+			if event.GetObjectKey() != "sessions/session-1/selfie.jpg" || event.GetArtifactType() != "SELFIE" {
+				t.Fatalf("DecodeEvent() = %v, want the artifact data", event)
+			}
+		})
+	}
+}
+
+func TestDecodeEventUpcastsOlderVersions(t *testing.T) {
+	withSchemaVersion(t, fullName[*proto.ArtifactUploaded](), 2)
+	// Version 2 renamed the object key field
+	RegisterUpcaster(SubjectArtifactUploaded, 1, RenameField("key", "object_key"))
+
+	v1 := strings.Replace(artifactData, `"object_key"`, `"key"`, 1)
+	for _, version := range []int{0, 1} {
+		event := &proto.ArtifactUploaded{}
+		if _, err := DecodeEvent(envelope(t, SubjectArtifactUploaded, version, v1), event); err != nil {
+			t.Fatalf("DecodeEvent() of version %d error = %v", version, err)
+		}
+		if got := event.GetObjectKey(); got != "sessions/session-1/selfie.jpg" {
+			t.Fatalf("DecodeEvent() of version %d object key = %q, want the upcast key", version, got)
+		}
+	}
+
+	event := &proto.ArtifactUploaded{}
+	if _, err := DecodeEvent(envelope(t, SubjectArtifactUploaded, 2, artifactData), event); err != nil {
+		t.Fatalf("DecodeEvent() of version 2 error = %v", err)
+	}
+}
+
+func TestUpcast(t *testing.T) {
+	schema := Schema{Subject: "test.upcast", Version: 3}
+	t.Cleanup(func() {
+		upcastersMu.Lock()
+		delete(upcasters, schema.Subject)
+		upcastersMu.Unlock()
+	})
+	RegisterUpcaster(schema.Subject, 1, RenameField("a", "b"))
+
+	data := json.RawMessage(`{"a":"x"}`)
+	if _, err := upcast(schema, 1, data); err == nil || !strings.Contains(err.Error(), "no upcaster for test.upcast from schema version 2") {
+		t.Fatalf("upcast() error = %v, want a missing upcaster", err)
+	}
+
+	RegisterUpcaster(schema.Subject, 2, RenameField("b", "c"))
+	got, err := upcast(schema, 1, data)
+	if err != nil {
+		t.Fatalf("upcast() error = %v", err)
+	}
+	if string(got) != `{"c":"x"}` {
+		t.Fatalf("upcast() = %s, want {\"c\":\"x\"}", got)
+	}
+
+	if got, err := upcast(schema, 3, data); err != nil || string(got) != string(data) {
+		t.Fatalf("upcast() at the current version = %s, %v, want the data unchanged", got, err)
+	}
+
+	failing := errors.New("broken")
+	RegisterUpcaster(schema.Subject, 2, func(json.RawMessage) (json.RawMessage, error) { return nil, failing })
+	if _, err := upcast(schema, 2, data); !errors.Is(err, failing) {
+		t.Fatalf("upcast() error = %v, want %v", err, failing)
+	}
+}
+
+func TestRenameField(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "renamed", data: `{"key":"k","id":1}`, want: `{"id":1,"object_key":"k"}`},
+		{name: "field absent", data: `{"id":1}`, want: `{"id":1}`},
+		{name: "both names", data: `{"key":"k","object_key":"k"}`, wantErr: true},
+		{name: "not an object", data: `[1]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenameField("key", "object_key")(json.RawMessage(tt.data))
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("RenameField() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && string(got) != tt.want {
+				t.Fatalf("RenameField() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
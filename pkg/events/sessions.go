@@ -0,0 +1,5 @@
+package events
+
+// SubjectSessionStatusChanged is published by identity after every session
+// transition, with a proto.SessionStatusChanged
+const SubjectSessionStatusChanged = "session.status_changed"
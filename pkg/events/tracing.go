@@ -0,0 +1,113 @@
+package events
+
+import (
+	"context"
+
+	ekycotel "github.com/ekyc-backend/pkg/otel"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans started by the event bus
+const tracerName = "github.com/ekyc-backend/pkg/events"
+
+// Header names carrying event metadata next to the payload
+const (
+	HeaderCorrelationID = "X-Correlation-ID"
+	HeaderSessionID     = "X-Session-ID"
+)
+
+// headerCarrier adapts NATS message headers to the OTel propagator
+type headerCarrier nats.Header
+
+func (c headerCarrier) Get(key string) string { return nats.Header(c).Get(key) }
+
+func (c headerCarrier) Set(key, value string) { nats.Header(c).Set(key, value) }
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// startPublish starts a producer span for an event and writes the trace
+// context, baggage, correlation ID and session ID to the message headers
+func startPublish(ctx context.Context, msg *nats.Msg, metadata EventMetadata) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, msg.Subject+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination.name", msg.Subject),
+			attribute.String("messaging.message.id", metadata.EventID),
+			attribute.String("nats.correlation_id", metadata.CorrelationID),
+			attribute.String("nats.session_id", metadata.SessionID),
+		),
+	)
+
+	if msg.Header == nil {
+		msg.Header = nats.Header{}
+	}
+	otel.GetTextMapPropagator().Inject(ekycotel.IDsToBaggage(ctx), headerCarrier(msg.Header))
+	if metadata.CorrelationID != "" {
+		msg.Header.Set(HeaderCorrelationID, metadata.CorrelationID)
+	}
+	if metadata.SessionID != "" {
+		msg.Header.Set(HeaderSessionID, metadata.SessionID)
+	}
+
+	return ctx, span
+}
+
+// startConsume restores the producer's trace context, baggage, correlation
+// ID and session ID from the message headers and starts a consumer span.
+// The span continues the producer's trace and links to the producer span.
+func startConsume(subject string, header nats.Header) (context.Context, trace.Span) {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), headerCarrier(header))
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination.name", subject),
+		),
+	}
+	if producer := trace.SpanContextFromContext(ctx); producer.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: producer}))
+	}
+	ctx, span := otel.Tracer(tracerName).Start(ctx, subject+" process", opts...)
+
+	if correlationID := header.Get(HeaderCorrelationID); correlationID != "" {
+		ctx = context.WithValue(ctx, "correlation_id", correlationID)
+	}
+	if sessionID := header.Get(HeaderSessionID); sessionID != "" {
+		ctx = context.WithValue(ctx, "session_id", sessionID)
+	}
+	ctx = ekycotel.IDsFromBaggage(ctx)
+
+	span.SetAttributes(
+		attribute.String("nats.correlation_id", stringValue(ctx, "correlation_id")),
+		attribute.String("nats.session_id", stringValue(ctx, "session_id")),
+	)
+
+	return ctx, span
+}
+
+// endSpan records a failed publish or handler on the span and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// stringValue returns a string context value, or "" if it is not set
+func stringValue(ctx context.Context, key string) string {
+	value, _ := ctx.Value(key).(string)
+	return value
+}
@@ -0,0 +1,159 @@
+package grpcmw
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// claimsContextKey is the context key under which verified claims are stored
+type claimsContextKey struct{}
+
+// DefaultPublicMethods are the methods callable without an access token
+var DefaultPublicMethods = []string{
+	"/ekyc.AuthService/SignIn",
+	"/ekyc.AuthService/SignUp",
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+}
+
+// AuthPolicy decides which methods need a token and which roles they require.
+// Methods that are neither public nor listed only need a valid token.
+type AuthPolicy struct {
+	public map[string]bool
+	roles  map[string][]string
+}
+
+// NewAuthPolicy creates a policy with DefaultPublicMethods exempted
+func NewAuthPolicy() *AuthPolicy {
+	p := &AuthPolicy{
+		public: make(map[string]bool),
+		roles:  make(map[string][]string),
+	}
+	return p.Public(DefaultPublicMethods...)
+}
+
+// Public exempts methods from authentication
+func (p *AuthPolicy) Public(methods ...string) *AuthPolicy {
+	for _, method := range methods {
+		p.public[method] = true
+	}
+	return p
+}
+
+// RequireAnyRole restricts a method to callers holding at least one of roles
+func (p *AuthPolicy) RequireAnyRole(method string, roles ...string) *AuthPolicy {
+	p.roles[method] = roles
+	return p
+}
+
+// authorize verifies the caller of a method and returns the context to continue with
+func (p *AuthPolicy) authorize(ctx context.Context, method string, verifier *jwtkeys.Verifier, denylist *jwtkeys.Denylist, log *logger.Logger) (context.Context, error) {
+	if p.public[method] {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata not provided")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization header not provided")
+	}
+
+	token := strings.TrimPrefix(values[0], "Bearer ")
+	if token == "" || token == values[0] {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	claims, err := verifier.Verify(token)
+	if err != nil {
+		log.WithContext(ctx).Warn("Invalid token",
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	// Check revocation. Fail closed: a token is not accepted if the denylist
+	// cannot be consulted.
+	revoked, err := denylist.IsRevoked(ctx, claims)
+	if err != nil {
+		log.WithContext(ctx).Error("Failed to check token revocation",
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Unavailable, "unable to verify token")
+	}
+	if revoked {
+		log.WithContext(ctx).Warn("Revoked token",
+			zap.String("method", method),
+			zap.String("user_id", claims.UserID),
+			zap.String("jti", claims.ID),
+		)
+		return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+
+	if roles, ok := p.roles[method]; ok && !claims.HasAnyRole(roles...) {
+		log.WithContext(ctx).Warn("Insufficient permissions",
+			zap.String("method", method),
+			zap.String("user_id", claims.UserID),
+			zap.Strings("required_roles", roles),
+			zap.Strings("user_roles", claims.Roles),
+		)
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+	}
+
+	return ContextWithClaims(ctx, claims), nil
+}
+
+// UnaryAuthInterceptor validates JWT tokens for unary gRPC calls and rejects
+// tokens revoked in denylist
+func UnaryAuthInterceptor(verifier *jwtkeys.Verifier, denylist *jwtkeys.Denylist, policy *AuthPolicy, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := policy.authorize(ctx, info.FullMethod, verifier, denylist, log)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor validates JWT tokens for streaming gRPC calls and
+// rejects tokens revoked in denylist
+func StreamAuthInterceptor(verifier *jwtkeys.Verifier, denylist *jwtkeys.Denylist, policy *AuthPolicy, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := policy.authorize(ss.Context(), info.FullMethod, verifier, denylist, log)
+		if err != nil {
+			return err
+		}
+
+		wrapped := &wrappedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		}
+
+		return handler(srv, wrapped)
+	}
+}
+
+// ContextWithClaims returns a context carrying verified token claims
+func ContextWithClaims(ctx context.Context, claims *jwtkeys.Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the verified token claims of the caller, if any
+func ClaimsFromContext(ctx context.Context) (*jwtkeys.Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*jwtkeys.Claims)
+	return claims, ok
+}
@@ -5,30 +5,42 @@ import (
 	"time"
 
 	"github.com/ekyc-backend/pkg/logger"
+	ekycotel "github.com/ekyc-backend/pkg/otel"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 )
 
-// UnaryTracingInterceptor adds OpenTelemetry tracing to unary gRPC calls
+// UnaryTracingInterceptor adds OpenTelemetry tracing to unary gRPC calls.
+// The correlation and session IDs received in baggage are set on the
+// context, so logs and published events carry them.
 func UnaryTracingInterceptor(serviceName string) grpc.UnaryServerInterceptor {
-	return otelgrpc.UnaryServerInterceptor(
+	tracing := otelgrpc.UnaryServerInterceptor(
 		otelgrpc.WithTracerProvider(otel.GetTracerProvider()),
 		otelgrpc.WithMeterProvider(otel.GetMeterProvider()),
 	)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return tracing(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(ekycotel.IDsFromBaggage(ctx), req)
+		})
+	}
 }
 
 // StreamTracingInterceptor adds OpenTelemetry tracing to streaming gRPC calls
+// and sets the correlation and session IDs received in baggage on the context
 func StreamTracingInterceptor(serviceName string) grpc.StreamServerInterceptor {
-	return otelgrpc.StreamServerInterceptor(
+	tracing := otelgrpc.StreamServerInterceptor(
 		otelgrpc.WithTracerProvider(otel.GetTracerProvider()),
 		otelgrpc.WithMeterProvider(otel.GetMeterProvider()),
 	)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return tracing(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ekycotel.IDsFromBaggage(ss.Context())})
+		})
+	}
 }
 
 // UnaryLoggingInterceptor adds structured logging to unary gRPC calls
@@ -97,76 +109,6 @@ func StreamLoggingInterceptor(log *logger.Logger) grpc.StreamServerInterceptor {
 	}
 }
 
-// UnaryAuthInterceptor validates JWT tokens for unary gRPC calls
-func UnaryAuthInterceptor(secret string) grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Skip auth for certain methods
-		if info.FullMethod == "/auth.AuthService/SignIn" || info.FullMethod == "/auth.AuthService/SignUp" {
-			return handler(ctx, req)
-		}
-
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "metadata not provided")
-		}
-
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "authorization header not provided")
-		}
-
-		// Validate token (simplified - in real implementation, use proper JWT validation)
-		if authHeader[0] == "" {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token")
-		}
-
-		// Add user info to context
-		ctx = context.WithValue(ctx, "user_id", "extracted_user_id")
-
-		return handler(ctx, req)
-	}
-}
-
-// StreamAuthInterceptor validates JWT tokens for streaming gRPC calls
-func StreamAuthInterceptor(secret string) grpc.StreamServerInterceptor {
-	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		ctx := ss.Context()
-
-		// Skip auth for certain methods
-		if info.FullMethod == "/auth.AuthService/SignIn" || info.FullMethod == "/auth.AuthService/SignUp" {
-			return handler(srv, ss)
-		}
-
-		// Extract token from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return status.Errorf(codes.Unauthenticated, "metadata not provided")
-		}
-
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return status.Errorf(codes.Unauthenticated, "authorization header not provided")
-		}
-
-		// Validate token (simplified)
-		if authHeader[0] == "" {
-			return status.Errorf(codes.Unauthenticated, "invalid token")
-		}
-
-		// Create new context with user info
-		newCtx := context.WithValue(ctx, "user_id", "extracted_user_id")
-
-		// Create wrapped stream
-		wrapped := &wrappedServerStream{
-			ServerStream: ss,
-			ctx:          newCtx,
-		}
-
-		return handler(srv, wrapped)
-	}
-}
-
 // wrappedServerStream wraps grpc.ServerStream to provide custom context
 type wrappedServerStream struct {
 	grpc.ServerStream
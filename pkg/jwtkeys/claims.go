@@ -0,0 +1,81 @@
+package jwtkeys
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the access token claims issued by the API gateway
+type Claims struct {
+	UserID string   `json:"sub"`
+	Roles  []string `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// HasRole reports whether the claims carry the given role
+func (c *Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyRole reports whether the claims carry at least one of the given roles
+func (c *Claims) HasAnyRole(roles ...string) bool {
+	for _, role := range roles {
+		if c.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// KeySource resolves the verification key for a token
+type KeySource interface {
+	Keyfunc(token *jwt.Token) (interface{}, error)
+}
+
+// Verifier validates access tokens against a key source
+type Verifier struct {
+	keys   KeySource
+	issuer string
+}
+
+// NewVerifier creates a new token verifier accepting tokens from issuer
+func NewVerifier(keys KeySource, issuer string) *Verifier {
+	return &Verifier{
+		keys:   keys,
+		issuer: issuer,
+	}
+}
+
+// Verify parses a token, checks its signature, kid, algorithm, issuer and
+// lifetime, and returns its claims
+func (v *Verifier) Verify(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, v.keys.Keyfunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	if claims.UserID == "" {
+		return nil, fmt.Errorf("token has no subject")
+	}
+
+	return claims, nil
+}
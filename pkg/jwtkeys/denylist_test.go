@@ -0,0 +1,33 @@
+package jwtkeys
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIssuedBefore(t *testing.T) {
+	cutoff := time.Date(2024, 3, 1, 12, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		cutoff   time.Time
+		want     bool
+	}{
+		{"no cutoff", cutoff, time.Time{}, false},
+		{"issued before the cutoff", cutoff.Add(-time.Minute), cutoff, true},
+		{"issued at the cutoff", cutoff, cutoff, true},
+		{"issued later in the cutoff second", cutoff.Add(999 * time.Millisecond), cutoff, true},
+		{"cutoff later in the issuing second", cutoff, cutoff.Add(500 * time.Millisecond), true},
+		{"issued in the next second", cutoff.Add(time.Second), cutoff, false},
+		{"issued after the cutoff", cutoff.Add(time.Hour), cutoff, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := issuedBefore(tt.issuedAt, tt.cutoff); got != tt.want {
+				t.Errorf("issuedBefore(%v, %v) = %v, want %v", tt.issuedAt, tt.cutoff, got, tt.want)
+			}
+		})
+	}
+}
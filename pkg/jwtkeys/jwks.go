@@ -0,0 +1,69 @@
+package jwtkeys
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"sort"
+)
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set document
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns every verification key as a JSON Web Key Set, ordered by key id
+func (k *KeySet) JWKS() JWKS {
+	kids := make([]string, 0, len(k.verification))
+	for kid := range k.verification {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	set := JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		switch key := k.verification[kid].(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "RSA",
+				Use: "sig",
+				Alg: "RS256",
+				Kid: kid,
+				N:   encode(key.N.Bytes()),
+				E:   encode(big.NewInt(int64(key.E)).Bytes()),
+			})
+		case *ecdsa.PublicKey:
+			size := (key.Curve.Params().BitSize + 7) / 8
+			set.Keys = append(set.Keys, JWK{
+				Kty: "EC",
+				Use: "sig",
+				Alg: "ES256",
+				Kid: kid,
+				Crv: key.Curve.Params().Name,
+				X:   encode(key.X.FillBytes(make([]byte, size))),
+				Y:   encode(key.Y.FillBytes(make([]byte, size))),
+			})
+		}
+	}
+
+	return set
+}
+
+// encode returns the unpadded base64url encoding used by JWK fields
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,229 @@
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA modulus accepted for signing or verification
+const minRSABits = 2048
+
+// SigningKey is the private key used to sign new tokens
+type SigningKey struct {
+	ID     string
+	Method jwt.SigningMethod
+	Key    crypto.Signer
+}
+
+// KeySet holds the active signing key and every key tokens may be verified with.
+// Keeping retired public keys in the set lets tokens signed before a rotation
+// stay valid until they expire.
+type KeySet struct {
+	signing      *SigningKey
+	verification map[string]crypto.PublicKey
+}
+
+// NewKeySet creates a key set. The signing key, if any, is added to the
+// verification keys automatically.
+func NewKeySet(signing *SigningKey, verification map[string]crypto.PublicKey) *KeySet {
+	keys := make(map[string]crypto.PublicKey, len(verification)+1)
+	for kid, key := range verification {
+		keys[kid] = key
+	}
+	if signing != nil {
+		keys[signing.ID] = signing.Key.Public()
+	}
+
+	return &KeySet{
+		signing:      signing,
+		verification: keys,
+	}
+}
+
+// Signing returns the active signing key, or nil for a verification-only set
+func (k *KeySet) Signing() *SigningKey {
+	return k.signing
+}
+
+// Keyfunc resolves the verification key for a token from its kid header
+func (k *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, err := tokenKeyID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchKey(token, kid, k.verification[kid])
+}
+
+// LoadSigningKey reads a PEM encoded RSA or P-256 ECDSA private key
+func LoadSigningKey(path, kid string) (*SigningKey, error) {
+	if kid == "" {
+		return nil, fmt.Errorf("signing key id is required")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing key %s is not PEM encoded", path)
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("signing key %s is not a private key", path)
+	}
+
+	method, err := methodFor(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{ID: kid, Method: method, Key: signer}, nil
+}
+
+// GenerateSigningKey creates an ephemeral ES256 signing key. Tokens signed
+// with it do not survive a restart, so it is only suitable for development.
+func GenerateSigningKey(kid string) (*SigningKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	return &SigningKey{ID: kid, Method: jwt.SigningMethodES256, Key: key}, nil
+}
+
+// LoadVerificationKeys reads every *.pem public key or certificate in dir.
+// The file name without its extension is used as the key id.
+func LoadVerificationKeys(dir string) (map[string]crypto.PublicKey, error) {
+	keys := make(map[string]crypto.PublicKey)
+	if dir == "" {
+		return keys, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list verification keys: %w", err)
+	}
+
+	for _, path := range paths {
+		key, err := loadPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		keys[kid] = key
+	}
+
+	return keys, nil
+}
+
+// loadPublicKey reads a PEM encoded public key or certificate
+func loadPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read verification key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("verification key %s is not PEM encoded", path)
+	}
+
+	var key crypto.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse verification key %s: %w", path, err)
+	}
+
+	if _, err := methodFor(key); err != nil {
+		return nil, fmt.Errorf("verification key %s: %w", path, err)
+	}
+
+	return key, nil
+}
+
+// tokenKeyID returns the kid header of a token
+func tokenKeyID(token *jwt.Token) (string, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return "", fmt.Errorf("token has no kid header")
+	}
+	return kid, nil
+}
+
+// matchKey checks that a resolved key exists and matches the token's algorithm
+func matchKey(token *jwt.Token, kid string, key crypto.PublicKey) (crypto.PublicKey, error) {
+	if key == nil {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	method, err := methodFor(key)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != method.Alg() {
+		return nil, fmt.Errorf("signing method %s does not match key %q", token.Method.Alg(), kid)
+	}
+
+	return key, nil
+}
+
+// methodFor returns the JWT signing method matching a public key
+func methodFor(key crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("RSA key must be at least %d bits", minRSABits)
+		}
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("ECDSA key must use the P-256 curve")
+		}
+		return jwt.SigningMethodES256, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -0,0 +1,178 @@
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// DefaultJWKSRefreshInterval is how long a fetched key set is trusted
+	DefaultJWKSRefreshInterval = 5 * time.Minute
+	// minJWKSRefetchInterval limits refetches triggered by unknown kids
+	minJWKSRefetchInterval = 30 * time.Second
+)
+
+// RemoteKeySet resolves verification keys from a JWKS endpoint. Keys are
+// fetched lazily, cached for the refresh interval and refetched early when a
+// token names a kid that is not cached yet, so a key rotation on the issuer
+// is picked up without a restart.
+type RemoteKeySet struct {
+	url      string
+	client   *http.Client
+	interval time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewRemoteKeySet creates a key source backed by the JWKS document at url
+func NewRemoteKeySet(url string, interval time.Duration) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:      url,
+		client:   &http.Client{Timeout: 5 * time.Second},
+		interval: interval,
+	}
+}
+
+// Keyfunc resolves the verification key for a token from its kid header
+func (r *RemoteKeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, err := tokenKeyID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := r.lookup(kid)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchKey(token, kid, key)
+}
+
+// lookup returns a cached key, refreshing the cache when it is stale or the kid is unknown
+func (r *RemoteKeySet) lookup(kid string) (crypto.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	age := time.Since(r.fetchedAt)
+	key, ok := r.keys[kid]
+	if ok && age < r.interval {
+		return key, nil
+	}
+	if !ok && r.keys != nil && age < minJWKSRefetchInterval {
+		return nil, nil
+	}
+
+	keys, err := r.fetch()
+	if err != nil {
+		// Keep verifying with the last known keys if the issuer is briefly unreachable
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	r.keys = keys
+	r.fetchedAt = time.Now()
+
+	return r.keys[kid], nil
+}
+
+// fetch downloads and parses the JWKS document
+func (r *RemoteKeySet) fetch() (map[string]crypto.PublicKey, error) {
+	resp, err := r.client.Get(r.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWK %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+// PublicKey decodes the JWK into an RSA or ECDSA public key
+func (j JWK) PublicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decode(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(j.E)
+		if err != nil {
+			return nil, err
+		}
+		key := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		if _, err := methodFor(key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	case "EC":
+		if j.Crv != elliptic.P256().Params().Name {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := decode(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("point is not on curve")
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+// decode reverses encode
+func decode(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64url value: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,103 @@
+// Command devca generates a local development CA and a certificate for every
+// service so the stack can run with mTLS offline.
+//
+//	go run ./pkg/mtls/cmd/devca -out deploy/certs
+//
+// An existing CA in the output directory is reused, so rerunning the command
+// only reissues service certificates.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/mtls"
+)
+
+// defaultServices also covers minio, whose certificate serves the bucket over
+// TLS so SSE-C requests never travel in plaintext
+const defaultServices = "api-gateway,identity,storage-svc,admin,doc-ocr,face-match,liveness,scoring,minio"
+
+func main() {
+	out := flag.String("out", "deploy/certs", "output directory")
+	trustDomain := flag.String("trust-domain", "ekyc.local", "SPIFFE trust domain")
+	services := flag.String("services", defaultServices, "comma separated service names")
+	validity := flag.Duration("validity", 90*24*time.Hour, "service certificate validity")
+	flag.Parse()
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	caCert, caKey, err := loadOrCreateCA(*out)
+	if err != nil {
+		log.Fatalf("Failed to prepare CA: %v", err)
+	}
+
+	for _, service := range strings.Split(*services, ",") {
+		service = strings.TrimSpace(service)
+		if service == "" {
+			continue
+		}
+
+		id := mtls.ServiceID(*trustDomain, service)
+		cert, key, err := mtls.IssueCertificate(caCert, caKey, id, []string{service, "localhost"}, *validity)
+		if err != nil {
+			log.Fatalf("Failed to issue certificate for %s: %v", service, err)
+		}
+
+		if err := write(*out, service+".pem", cert, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		if err := write(*out, service+"-key.pem", key, 0o600); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("issued %s\n", id)
+	}
+}
+
+// loadOrCreateCA reuses ca.pem and ca-key.pem in dir or creates them
+func loadOrCreateCA(dir string) ([]byte, []byte, error) {
+	cert, certErr := os.ReadFile(filepath.Join(dir, "ca.pem"))
+	key, keyErr := os.ReadFile(filepath.Join(dir, "ca-key.pem"))
+	if certErr == nil && keyErr == nil {
+		return cert, key, nil
+	}
+	if !errors.Is(certErr, fs.ErrNotExist) && certErr != nil {
+		return nil, nil, certErr
+	}
+
+	cert, key, err := mtls.GenerateCA("ekyc-backend dev CA", 365*24*time.Hour)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := write(dir, "ca.pem", cert, 0o644); err != nil {
+		return nil, nil, err
+	}
+	if err := write(dir, "ca-key.pem", key, 0o600); err != nil {
+		return nil, nil, err
+	}
+
+	fmt.Println("created development CA")
+	return cert, key, nil
+}
+
+// write writes a file atomically so watching services never read a partial file
+func write(dir, name string, data []byte, perm os.FileMode) error {
+	tmp := filepath.Join(dir, "."+name+".tmp")
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, name)); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
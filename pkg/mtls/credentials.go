@@ -0,0 +1,145 @@
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ServerTLSConfig returns a TLS config that presents the source's certificate
+// and requires clients to present one signed by the source's CA
+func ServerTLSConfig(src *Source) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		// A fresh config per handshake picks up reloaded certificates and CAs
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS13,
+				Certificates: []tls.Certificate{*src.Certificate()},
+				ClientCAs:    src.Pool(),
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+			}, nil
+		},
+	}
+}
+
+// ClientTLSConfig returns a TLS config that presents the source's certificate
+// and verifies the server against the source's CA
+func ClientTLSConfig(src *Source) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return src.Certificate(), nil
+		},
+		// RootCAs is fixed once set, so the built-in verification is replaced
+		// by VerifyConnection, which checks the chain and host name against
+		// the current CA pool instead. Verification is not skipped.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return verifyServer(cs, src.Pool())
+		},
+	}
+}
+
+// verifyServer verifies the server certificate chain and name against pool
+func verifyServer(cs tls.ConnectionState, pool *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		DNSName:       cs.ServerName,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify server certificate: %w", err)
+	}
+
+	return nil
+}
+
+// Options names the TLS files of a service
+type Options struct {
+	CAFile         string
+	CertFile       string
+	KeyFile        string
+	ReloadInterval time.Duration
+	// Required rejects a missing configuration instead of falling back to
+	// insecure transport
+	Required bool
+}
+
+// Enabled reports whether mTLS material is configured
+func (o Options) Enabled() bool {
+	return o.CAFile != "" && o.CertFile != "" && o.KeyFile != ""
+}
+
+// OptionsFromConfig reads the TLS options from the shared service configuration.
+// mTLS is required outside development.
+func OptionsFromConfig(cfg *config.Config) Options {
+	return Options{
+		CAFile:         cfg.TLSCAFile,
+		CertFile:       cfg.TLSCertFile,
+		KeyFile:        cfg.TLSKeyFile,
+		ReloadInterval: cfg.TLSReloadInterval,
+		Required:       cfg.Environment != "development",
+	}
+}
+
+// Load returns a source when mTLS is configured. A missing configuration is
+// an error when it is required and otherwise yields nil, which makes
+// ServerOption and DialOption fall back to insecure transport.
+func Load(opts Options, logger *logger.Logger) (*Source, error) {
+	if opts.Enabled() {
+		interval := opts.ReloadInterval
+		if interval <= 0 {
+			interval = DefaultReloadInterval
+		}
+		return NewSource(opts.CAFile, opts.CertFile, opts.KeyFile, interval, logger)
+	}
+	if opts.Required {
+		return nil, errors.New("mTLS is required: set TLS_CA_FILE, TLS_CERT_FILE and TLS_KEY_FILE")
+	}
+	logger.Warn("mTLS is not configured, using insecure gRPC transport")
+	return nil, nil
+}
+
+// ServerOptions returns the transport credentials and peer identity
+// interceptors for a server, which admit only the callers allowed by policy.
+// Interceptors chained by options appended after these run after the peer
+// check. A nil source yields an insecure server without peer identities and
+// is only accepted in development.
+func ServerOptions(src *Source, policy *PeerPolicy) []grpc.ServerOption {
+	if src == nil {
+		return []grpc.ServerOption{grpc.Creds(insecure.NewCredentials())}
+	}
+	return []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(ServerTLSConfig(src))),
+		grpc.ChainUnaryInterceptor(UnaryPeerInterceptor(policy)),
+		grpc.ChainStreamInterceptor(StreamPeerInterceptor(policy)),
+	}
+}
+
+// DialOption returns the transport credentials option for grpc.Dial. A nil
+// source yields an insecure connection and is only accepted in development.
+func DialOption(src *Source) grpc.DialOption {
+	if src == nil {
+		return grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(ClientTLSConfig(src)))
+}
@@ -0,0 +1,198 @@
+package mtls
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// SPIFFEScheme is the URI scheme of SPIFFE IDs
+const SPIFFEScheme = "spiffe"
+
+// peerContextKey is the context key under which the peer identity is stored
+type peerContextKey struct{}
+
+// PeerIdentity is the SPIFFE-style identity carried in a peer certificate's
+// URI SAN, e.g. spiffe://ekyc.local/api-gateway
+type PeerIdentity struct {
+	TrustDomain string
+	Path        string
+}
+
+// String returns the identity as a SPIFFE ID
+func (p PeerIdentity) String() string {
+	return fmt.Sprintf("%s://%s%s", SPIFFEScheme, p.TrustDomain, p.Path)
+}
+
+// Service returns the last path segment, which names the calling service
+func (p PeerIdentity) Service() string {
+	return p.Path[strings.LastIndex(p.Path, "/")+1:]
+}
+
+// ServiceID builds the SPIFFE ID of a service in a trust domain
+func ServiceID(trustDomain, service string) string {
+	return PeerIdentity{TrustDomain: trustDomain, Path: "/" + service}.String()
+}
+
+// IdentityFromCertificate extracts the SPIFFE ID from a certificate's URI SANs
+func IdentityFromCertificate(cert *x509.Certificate) (PeerIdentity, error) {
+	for _, uri := range cert.URIs {
+		if uri.Scheme == SPIFFEScheme {
+			return parseIdentity(uri)
+		}
+	}
+	return PeerIdentity{}, fmt.Errorf("certificate has no %s URI SAN", SPIFFEScheme)
+}
+
+// parseIdentity validates a SPIFFE URI
+func parseIdentity(uri *url.URL) (PeerIdentity, error) {
+	if uri.Host == "" || uri.Path == "" || uri.User != nil || uri.RawQuery != "" || uri.Fragment != "" {
+		return PeerIdentity{}, fmt.Errorf("invalid SPIFFE ID %q", uri.String())
+	}
+	return PeerIdentity{TrustDomain: uri.Host, Path: uri.Path}, nil
+}
+
+// PeerFromContext returns the verified identity of the calling service, if any
+func PeerFromContext(ctx context.Context) (PeerIdentity, bool) {
+	if id, ok := ctx.Value(peerContextKey{}).(PeerIdentity); ok {
+		return id, true
+	}
+
+	id, err := identityFromPeer(ctx)
+	if err != nil {
+		return PeerIdentity{}, false
+	}
+	return id, true
+}
+
+// RequirePeer returns a PermissionDenied error unless the caller is one of the given SPIFFE IDs
+func RequirePeer(ctx context.Context, allowed ...string) error {
+	id, ok := PeerFromContext(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "caller has no verified service identity")
+	}
+	for _, a := range allowed {
+		if id.String() == a {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "service %s is not allowed to call this method", id)
+}
+
+// identityFromPeer reads the identity from the verified TLS chain of the connection
+func identityFromPeer(ctx context.Context) (PeerIdentity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return PeerIdentity{}, fmt.Errorf("no peer in context")
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return PeerIdentity{}, fmt.Errorf("connection is not using TLS")
+	}
+
+	if len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return PeerIdentity{}, fmt.Errorf("peer certificate was not verified")
+	}
+
+	return IdentityFromCertificate(info.State.VerifiedChains[0][0])
+}
+
+// PeerPolicy lists the services allowed to call a server. Every method
+// accepts the services allowed server-wide unless it has an allowlist of its
+// own. Callers must belong to the policy's trust domain.
+type PeerPolicy struct {
+	trustDomain string
+	services    []string
+	methods     map[string][]string
+}
+
+// NewPeerPolicy creates a policy for callers in trustDomain that allows no
+// service until Allow or AllowMethod is called
+func NewPeerPolicy(trustDomain string) *PeerPolicy {
+	return &PeerPolicy{
+		trustDomain: trustDomain,
+		methods:     make(map[string][]string),
+	}
+}
+
+// Allow lets services call every method without an allowlist of its own
+func (p *PeerPolicy) Allow(services ...string) *PeerPolicy {
+	p.services = append(p.services, services...)
+	return p
+}
+
+// AllowMethod restricts a method to the given services
+func (p *PeerPolicy) AllowMethod(method string, services ...string) *PeerPolicy {
+	p.methods[method] = append(p.methods[method], services...)
+	return p
+}
+
+// authorize checks the caller stored in ctx against the allowlist of method
+func (p *PeerPolicy) authorize(ctx context.Context, method string) error {
+	services, ok := p.methods[method]
+	if !ok {
+		services = p.services
+	}
+
+	allowed := make([]string, len(services))
+	for i, service := range services {
+		allowed[i] = ServiceID(p.trustDomain, service)
+	}
+	return RequirePeer(ctx, allowed...)
+}
+
+// UnaryPeerInterceptor rejects calls from peers without a verified SPIFFE ID
+// or not allowed by policy, and stores the identity in the context for
+// handlers
+func UnaryPeerInterceptor(policy *PeerPolicy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id, err := identityFromPeer(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "peer identity: %v", err)
+		}
+		ctx = context.WithValue(ctx, peerContextKey{}, id)
+		if err := policy.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamPeerInterceptor rejects streams from peers without a verified SPIFFE
+// ID or not allowed by policy, and stores the identity in the stream context
+// for handlers
+func StreamPeerInterceptor(policy *PeerPolicy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id, err := identityFromPeer(ss.Context())
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, "peer identity: %v", err)
+		}
+		ctx := context.WithValue(ss.Context(), peerContextKey{}, id)
+		if err := policy.authorize(ctx, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &peerServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+	}
+}
+
+// peerServerStream wraps grpc.ServerStream to carry the peer identity
+type peerServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *peerServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,50 @@
+package mtls
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPeerPolicyAuthorize(t *testing.T) {
+	policy := NewPeerPolicy("ekyc.local").
+		Allow("api-gateway").
+		AllowMethod("/ekyc.AdminService/ApplyDecision", "admin")
+
+	tests := []struct {
+		name   string
+		caller *PeerIdentity
+		method string
+		want   codes.Code
+	}{
+		{"allowed server-wide", &PeerIdentity{"ekyc.local", "/api-gateway"}, "/ekyc.IdentityService/CreateSession", codes.OK},
+		{"not allowed server-wide", &PeerIdentity{"ekyc.local", "/doc-ocr"}, "/ekyc.IdentityService/CreateSession", codes.PermissionDenied},
+		{"allowed for the method", &PeerIdentity{"ekyc.local", "/admin"}, "/ekyc.AdminService/ApplyDecision", codes.OK},
+		{"method allowlist replaces the server-wide one", &PeerIdentity{"ekyc.local", "/api-gateway"}, "/ekyc.AdminService/ApplyDecision", codes.PermissionDenied},
+		{"other trust domain", &PeerIdentity{"evil.local", "/api-gateway"}, "/ekyc.IdentityService/CreateSession", codes.PermissionDenied},
+		{"no identity", nil, "/ekyc.IdentityService/CreateSession", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.caller != nil {
+				ctx = context.WithValue(ctx, peerContextKey{}, *tt.caller)
+			}
+
+			err := policy.authorize(ctx, tt.method)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeerPolicyAllowsNothingByDefault(t *testing.T) {
+	ctx := context.WithValue(context.Background(), peerContextKey{}, PeerIdentity{"ekyc.local", "/api-gateway"})
+	if err := NewPeerPolicy("ekyc.local").authorize(ctx, "/ekyc.IdentityService/CreateSession"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("authorize() = %v, want PermissionDenied", err)
+	}
+}
@@ -0,0 +1,153 @@
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// DefaultReloadInterval is how often certificate files are checked for changes
+const DefaultReloadInterval = 30 * time.Second
+
+// Source holds the current certificate, key and CA pool loaded from disk and
+// reloads them when any of the files change. Handshakes always use the latest
+// successfully loaded material, so rotated certificates are picked up without
+// a restart.
+type Source struct {
+	caFile   string
+	certFile string
+	keyFile  string
+	logger   *logger.Logger
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewSource loads the CA, certificate and key and starts watching them for changes
+func NewSource(caFile, certFile, keyFile string, interval time.Duration, logger *logger.Logger) (*Source, error) {
+	s := &Source{
+		caFile:   caFile,
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger,
+		stop:     make(chan struct{}),
+	}
+
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	go s.watch(interval)
+
+	return s, nil
+}
+
+// Close stops watching the certificate files
+func (s *Source) Close() {
+	s.once.Do(func() { close(s.stop) })
+}
+
+// Certificate returns the current certificate
+func (s *Source) Certificate() *tls.Certificate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cert
+}
+
+// Pool returns the current CA pool
+func (s *Source) Pool() *x509.CertPool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.pool
+}
+
+// watch polls the files and reloads them when their modification time changes
+func (s *Source) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			modTime, err := s.latestModTime()
+			if err != nil {
+				s.logger.Error("Failed to stat TLS files", zap.Error(err))
+				continue
+			}
+
+			s.mu.RLock()
+			changed := modTime.After(s.modTime)
+			s.mu.RUnlock()
+			if !changed {
+				continue
+			}
+
+			// A failed reload keeps the previous material; files are often
+			// replaced one at a time and the next tick will pick up the full set
+			if err := s.reload(); err != nil {
+				s.logger.Error("Failed to reload TLS files", zap.Error(err))
+				continue
+			}
+			s.logger.Info("TLS certificates reloaded", zap.String("cert_file", s.certFile))
+		}
+	}
+}
+
+// reload reads all files and swaps them in if they are valid
+func (s *Source) reload() error {
+	modTime, err := s.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(s.caFile)
+	if err != nil {
+		return fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return fmt.Errorf("no certificates found in CA file %s", s.caFile)
+	}
+
+	s.mu.Lock()
+	s.cert = &cert
+	s.pool = pool
+	s.modTime = modTime
+	s.mu.Unlock()
+
+	return nil
+}
+
+// latestModTime returns the most recent modification time of the watched files
+func (s *Source) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{s.caFile, s.certFile, s.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to stat %s: %w", path, err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
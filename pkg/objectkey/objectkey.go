@@ -0,0 +1,58 @@
+package objectkey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Prefix is the top-level folder holding all session artifacts
+const Prefix = "sessions"
+
+// ErrInvalidKey is returned when an object key does not follow the session layout
+var ErrInvalidKey = errors.New("invalid object key")
+
+// Key is a parsed artifact object key of the form
+// sessions/{session_id}/{artifact_type}/{uuid}
+type Key struct {
+	SessionID    string
+	ArtifactType string
+	ID           string
+}
+
+// New generates a fresh object key for an artifact of a session
+func New(sessionID, artifactType string) Key {
+	return Key{
+		SessionID:    sessionID,
+		ArtifactType: artifactType,
+		ID:           uuid.New().String(),
+	}
+}
+
+// String renders the key in its storage form
+func (k Key) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", Prefix, k.SessionID, k.ArtifactType, k.ID)
+}
+
+// SessionPrefix returns the folder holding every artifact of a session
+func SessionPrefix(sessionID string) string {
+	return fmt.Sprintf("%s/%s/", Prefix, sessionID)
+}
+
+// Parse validates an object key and splits it into its parts
+func Parse(key string) (Key, error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 4 || parts[0] != Prefix || parts[2] == "" {
+		return Key{}, fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	if _, err := uuid.Parse(parts[1]); err != nil {
+		return Key{}, fmt.Errorf("%w: session id %q", ErrInvalidKey, parts[1])
+	}
+	if _, err := uuid.Parse(parts[3]); err != nil {
+		return Key{}, fmt.Errorf("%w: object id %q", ErrInvalidKey, parts[3])
+	}
+
+	return Key{SessionID: parts[1], ArtifactType: parts[2], ID: parts[3]}, nil
+}
@@ -0,0 +1,49 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// Request identifiers carried in W3C baggage, under the same names as the
+// context values read by the logger and the event bus
+const (
+	CorrelationIDKey = "correlation_id"
+	SessionIDKey     = "session_id"
+)
+
+// IDsToBaggage adds the correlation and session IDs held in ctx to its
+// baggage, so the propagator sends them with outgoing calls and events
+func IDsToBaggage(ctx context.Context) context.Context {
+	bag := baggage.FromContext(ctx)
+	for _, key := range []string{CorrelationIDKey, SessionIDKey} {
+		value, ok := ctx.Value(key).(string)
+		if !ok || value == "" {
+			continue
+		}
+		member, err := baggage.NewMemberRaw(key, value)
+		if err != nil {
+			continue
+		}
+		if updated, err := bag.SetMember(member); err == nil {
+			bag = updated
+		}
+	}
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// IDsFromBaggage sets the correlation and session IDs found in the baggage
+// of ctx as context values, unless ctx already holds them
+func IDsFromBaggage(ctx context.Context) context.Context {
+	bag := baggage.FromContext(ctx)
+	for _, key := range []string{CorrelationIDKey, SessionIDKey} {
+		if value, ok := ctx.Value(key).(string); ok && value != "" {
+			continue
+		}
+		if value := bag.Member(key).Value(); value != "" {
+			ctx = context.WithValue(ctx, key, value)
+		}
+	}
+	return ctx
+}
@@ -0,0 +1,64 @@
+// Command piirotatekeys rewraps every person_pii data key under the current
+// primary KMS master key. Run it alongside storage-svc's rotatekeys after
+// adding a master key with kmskey; the encrypted fields are left untouched.
+// Older master keys can be removed from the key file once both report no
+// stale keys. It is safe to re-run and to run from several hosts at once.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/pii"
+	"go.uber.org/zap"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 500, "data keys rewrapped per transaction")
+	flag.Parse()
+
+	cfg := config.Load()
+
+	log := logger.New("piirotatekeys")
+	defer log.Sync()
+
+	kms, err := crypto.LoadKMS(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to load KMS", zap.Error(err))
+	}
+
+	index, err := crypto.LoadBlindIndex(cfg)
+	if err != nil {
+		log.Fatal("Failed to load blind index key", zap.Error(err))
+	}
+
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	store, err := pii.NewStore(database, kms, index)
+	if err != nil {
+		log.Fatal("Failed to initialize PII store", zap.Error(err))
+	}
+
+	ctx := context.Background()
+	total := 0
+	for {
+		rotated, err := store.RotateKeys(ctx, *batchSize)
+		if err != nil {
+			log.Fatal("Failed to rotate PII data keys", zap.Error(err), zap.Int("rotated", total))
+		}
+		if rotated == 0 {
+			break
+		}
+		total += rotated
+	}
+
+	log.Info("PII data key rotation complete", zap.Int("rotated", total))
+}
@@ -0,0 +1,420 @@
+package pii
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Encrypted field names, used as associated data for their ciphertexts
+const (
+	FieldFullName    = "full_name"
+	FieldIDNumber    = "id_number"
+	FieldDateOfBirth = "dob"
+	FieldAddress     = "address_text"
+)
+
+// dateLayout is how dates are rendered before encryption
+const dateLayout = "2006-01-02"
+
+// Person holds the personal data extracted for a session
+type Person struct {
+	SessionID   string
+	FullName    string
+	IDNumber    string
+	DateOfBirth *time.Time
+	IssueDate   *time.Time
+	ExpiryDate  *time.Time
+	Address     string
+	UpdatedAt   time.Time
+}
+
+// Store reads and writes person_pii with full_name, id_number, dob and
+// address_text encrypted under a per-row data key
+type Store struct {
+	db    *db.DB
+	kms   crypto.KMS
+	index *crypto.BlindIndex
+}
+
+// NewStore creates a PII store. PII is never written in the clear, so a KMS
+// and a blind index are required.
+func NewStore(database *db.DB, kms crypto.KMS, index *crypto.BlindIndex) (*Store, error) {
+	if kms == nil {
+		return nil, fmt.Errorf("PII encryption requires a KMS")
+	}
+	if index == nil {
+		return nil, fmt.Errorf("PII encryption requires a blind index key")
+	}
+
+	return &Store{db: database, kms: kms, index: index}, nil
+}
+
+// Upsert encrypts and stores the personal data of a session, replacing any
+// previous record with a fresh data key
+func (s *Store) Upsert(ctx context.Context, p *Person) error {
+	err := s.db.Exec(ctx, `INSERT INTO person_pii (session_id) VALUES ($1) ON CONFLICT (session_id) DO NOTHING`, p.SessionID)
+	if err != nil {
+		return fmt.Errorf("failed to create person: %w", err)
+	}
+
+	return s.write(ctx, s.db.GetPool(), p)
+}
+
+// Get loads and decrypts the personal data of a session
+func (s *Store) Get(ctx context.Context, sessionID string) (*Person, error) {
+	row := s.db.QueryRow(ctx, `SELECT `+personColumns+` FROM person_pii WHERE session_id = $1`, sessionID)
+
+	p, err := s.scanPerson(ctx, row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get person: %w", err)
+	}
+
+	return p, nil
+}
+
+// Update applies fn to the personal data of a session and stores the result.
+// The row is created if missing and locked while fn runs, so concurrent
+// updates, such as the two sides of an ID card, are merged rather than lost.
+func (s *Store) Update(ctx context.Context, sessionID string, fn func(p *Person)) (*Person, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `INSERT INTO person_pii (session_id) VALUES ($1) ON CONFLICT (session_id) DO NOTHING`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create person: %w", err)
+	}
+
+	row := tx.QueryRow(ctx, `SELECT `+personColumns+` FROM person_pii WHERE session_id = $1 FOR UPDATE`, sessionID)
+	p, err := s.scanPerson(ctx, row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock person: %w", err)
+	}
+
+	fn(p)
+
+	if err := s.write(ctx, tx, p); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return p, nil
+}
+
+// FindSessionsByIDNumber returns the other sessions that recorded the same ID
+// number, matched through the blind index
+func (s *Store) FindSessionsByIDNumber(ctx context.Context, idNumber, excludeSessionID string) ([]string, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT session_id::text FROM person_pii
+		WHERE id_number_bidx = $1 AND session_id::text <> $2
+		ORDER BY updated_at DESC`,
+		s.idNumberIndex(idNumber), excludeSessionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sessions by id number: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan session id: %w", err)
+		}
+		sessions = append(sessions, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find sessions by id number: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// Backfill encrypts up to batchSize rows still stored in the clear and empties
+// their clear columns. It returns how many rows were encrypted; callers repeat
+// until it returns zero. Rows are locked with SKIP LOCKED so several runs can
+// proceed side by side.
+func (s *Store) Backfill(ctx context.Context, batchSize int) (int, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT `+personColumns+` FROM person_pii
+		WHERE wrapped_key IS NULL
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`,
+		batchSize,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select clear rows: %w", err)
+	}
+
+	var pending []*Person
+	for rows.Next() {
+		p, err := s.scanPerson(ctx, rows)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan clear row: %w", err)
+		}
+		pending = append(pending, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to select clear rows: %w", err)
+	}
+
+	for _, p := range pending {
+		if err := s.write(ctx, tx, p); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(pending), nil
+}
+
+// RotateKeys rewraps up to batchSize row data keys that are not wrapped under
+// the primary master key. The field ciphertexts are left untouched. It
+// returns how many keys were rewrapped; callers repeat until it returns zero.
+// Rows are locked with SKIP LOCKED so several runs can proceed side by side.
+func (s *Store) RotateKeys(ctx context.Context, batchSize int) (int, error) {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT session_id::text, master_key_id, wrapped_key FROM person_pii
+		WHERE wrapped_key IS NOT NULL AND master_key_id IS DISTINCT FROM $1
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`,
+		s.kms.PrimaryKeyID(), batchSize,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select stale keys: %w", err)
+	}
+
+	stale := map[string]crypto.WrappedKey{}
+	for rows.Next() {
+		var (
+			sessionID string
+			wrapped   crypto.WrappedKey
+		)
+		if err := rows.Scan(&sessionID, &wrapped.MasterKeyID, &wrapped.Ciphertext); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan stale key: %w", err)
+		}
+		stale[sessionID] = wrapped
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to select stale keys: %w", err)
+	}
+
+	for sessionID, wrapped := range stale {
+		rewrapped, _, err := crypto.Rewrap(ctx, s.kms, wrapped)
+		if err != nil {
+			return 0, fmt.Errorf("failed to rewrap data key of person %s: %w", sessionID, err)
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE person_pii SET master_key_id = $2, wrapped_key = $3 WHERE session_id = $1`,
+			sessionID, rewrapped.MasterKeyID, rewrapped.Ciphertext)
+		if err != nil {
+			return 0, fmt.Errorf("failed to store data key of person %s: %w", sessionID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(stale), nil
+}
+
+// execer is satisfied by both the pool and a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// personColumns are the columns read by scanPerson
+const personColumns = `session_id::text, issue_date, expiry_date, COALESCE(master_key_id, ''), wrapped_key,
+	full_name_enc, id_number_enc, dob_enc, address_text_enc,
+	COALESCE(full_name, ''), COALESCE(id_number, ''), dob, COALESCE(address_text, ''), updated_at`
+
+// write encrypts a person under a fresh data key into its existing row and
+// empties any clear columns left from before encryption
+func (s *Store) write(ctx context.Context, db execer, p *Person) error {
+	cipher, wrapped, err := crypto.NewRecordCipher(ctx, s.kms, p.SessionID)
+	if err != nil {
+		return err
+	}
+
+	enc, err := encryptFields(cipher, p)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(ctx, `
+		UPDATE person_pii SET
+			issue_date = $2,
+			expiry_date = $3,
+			master_key_id = $4,
+			wrapped_key = $5,
+			full_name_enc = $6,
+			id_number_enc = $7,
+			dob_enc = $8,
+			address_text_enc = $9,
+			id_number_bidx = $10,
+			full_name = NULL,
+			id_number = NULL,
+			dob = NULL,
+			address_text = NULL
+		WHERE session_id = $1`,
+		p.SessionID, p.IssueDate, p.ExpiryDate, wrapped.MasterKeyID, wrapped.Ciphertext,
+		enc.fullName, enc.idNumber, enc.dob, enc.address, s.idNumberIndex(p.IDNumber),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write person %s: %w", p.SessionID, err)
+	}
+
+	return nil
+}
+
+// scanPerson scans a row selected with personColumns and decrypts it. Rows
+// written before encryption are read from their clear columns.
+func (s *Store) scanPerson(ctx context.Context, row pgx.Row) (*Person, error) {
+	var (
+		p       Person
+		wrapped crypto.WrappedKey
+		enc     encryptedFields
+	)
+
+	err := row.Scan(&p.SessionID, &p.IssueDate, &p.ExpiryDate, &wrapped.MasterKeyID, &wrapped.Ciphertext,
+		&enc.fullName, &enc.idNumber, &enc.dob, &enc.address,
+		&p.FullName, &p.IDNumber, &p.DateOfBirth, &p.Address, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped.Ciphertext) == 0 {
+		return &p, nil
+	}
+
+	cipher, err := crypto.OpenRecordCipher(ctx, s.kms, p.SessionID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if err := decryptFields(cipher, &enc, &p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// idNumberIndex returns the blind index of an ID number, or nil when empty
+func (s *Store) idNumberIndex(idNumber string) *string {
+	normalized := NormalizeIDNumber(idNumber)
+	if normalized == "" {
+		return nil
+	}
+	digest := s.index.Compute(FieldIDNumber, normalized)
+	return &digest
+}
+
+// NormalizeIDNumber canonicalizes an ID number before indexing, so the same
+// document read with different spacing or case yields the same index
+func NormalizeIDNumber(idNumber string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '.' {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, idNumber)
+}
+
+// encryptedFields holds the ciphertexts of a person record
+type encryptedFields struct {
+	fullName []byte
+	idNumber []byte
+	dob      []byte
+	address  []byte
+}
+
+// encryptFields encrypts the sensitive fields of a person
+func encryptFields(cipher *crypto.RecordCipher, p *Person) (*encryptedFields, error) {
+	var (
+		enc encryptedFields
+		err error
+		dob string
+	)
+
+	if p.DateOfBirth != nil {
+		dob = p.DateOfBirth.Format(dateLayout)
+	}
+
+	if enc.fullName, err = cipher.EncryptField(FieldFullName, p.FullName); err != nil {
+		return nil, err
+	}
+	if enc.idNumber, err = cipher.EncryptField(FieldIDNumber, p.IDNumber); err != nil {
+		return nil, err
+	}
+	if enc.dob, err = cipher.EncryptField(FieldDateOfBirth, dob); err != nil {
+		return nil, err
+	}
+	if enc.address, err = cipher.EncryptField(FieldAddress, p.Address); err != nil {
+		return nil, err
+	}
+
+	return &enc, nil
+}
+
+// decryptFields decrypts the sensitive fields of a person record into p
+func decryptFields(cipher *crypto.RecordCipher, enc *encryptedFields, p *Person) error {
+	var err error
+
+	if p.FullName, err = cipher.DecryptField(FieldFullName, enc.fullName); err != nil {
+		return err
+	}
+	if p.IDNumber, err = cipher.DecryptField(FieldIDNumber, enc.idNumber); err != nil {
+		return err
+	}
+	if p.Address, err = cipher.DecryptField(FieldAddress, enc.address); err != nil {
+		return err
+	}
+
+	dob, err := cipher.DecryptField(FieldDateOfBirth, enc.dob)
+	if err != nil {
+		return err
+	}
+	if dob != "" {
+		parsed, err := time.Parse(dateLayout, dob)
+		if err != nil {
+			return fmt.Errorf("failed to parse dob: %w", err)
+		}
+		p.DateOfBirth = &parsed
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package pii
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ekyc-backend/pkg/crypto"
+)
+
+func TestNormalizeIDNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		idNumber string
+		want     string
+	}{
+		{name: "already normalized", idNumber: "001099012345", want: "001099012345"},
+		{name: "spaces", idNumber: "001 099 012 345", want: "001099012345"},
+		{name: "dashes and dots", idNumber: "001-099.012-345", want: "001099012345"},
+		{name: "surrounding whitespace", idNumber: "\t001099012345\n", want: "001099012345"},
+		{name: "lower case passport", idNumber: "c1234567", want: "C1234567"},
+		{name: "empty", idNumber: "", want: ""},
+		{name: "separators only", idNumber: " - . ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeIDNumber(tt.idNumber); got != tt.want {
+				t.Errorf("NormalizeIDNumber(%q) = %q, want %q", tt.idNumber, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIDNumberIndex(t *testing.T) {
+	index, err := crypto.NewBlindIndex(bytes.Repeat([]byte{4}, crypto.KeySize))
+	if err != nil {
+		t.Fatalf("NewBlindIndex() error = %v", err)
+	}
+	store := &Store{index: index}
+
+	base := store.idNumberIndex("C1234567")
+	if base == nil {
+		t.Fatal("idNumberIndex() = nil for a non-empty ID number")
+	}
+
+	tests := []struct {
+		name     string
+		idNumber string
+		equal    bool
+	}{
+		{name: "same reading", idNumber: "C1234567", equal: true},
+		{name: "different spacing and case", idNumber: " c 123-4567 ", equal: true},
+		{name: "different number", idNumber: "C1234568"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := store.idNumberIndex(tt.idNumber)
+			if got == nil {
+				t.Fatalf("idNumberIndex(%q) = nil", tt.idNumber)
+			}
+			if (*got == *base) != tt.equal {
+				t.Errorf("idNumberIndex(%q) = %s, base %s, want equal %v", tt.idNumber, *got, *base, tt.equal)
+			}
+		})
+	}
+
+	if got := store.idNumberIndex(" - "); got != nil {
+		t.Errorf("idNumberIndex of separators only = %s, want nil", *got)
+	}
+}
+
+// fakeRow returns fixed values from Scan, in personColumns order
+type fakeRow []interface{}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	for i, value := range r {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+	}
+	return nil
+}
+
+// personRow lays out an encrypted person record as scanPerson reads it
+func personRow(sessionID string, wrapped crypto.WrappedKey, enc *encryptedFields) fakeRow {
+	var none *time.Time
+	return fakeRow{sessionID, none, none, wrapped.MasterKeyID, wrapped.Ciphertext,
+		enc.fullName, enc.idNumber, enc.dob, enc.address,
+		"", "", none, "", time.Time{}}
+}
+
+func TestRotatedKeyDecrypts(t *testing.T) {
+	ctx := context.Background()
+	oldKey := bytes.Repeat([]byte{1}, crypto.KeySize)
+	newKey := bytes.Repeat([]byte{2}, crypto.KeySize)
+
+	before, err := crypto.NewLocalKMS("old", map[string][]byte{"old": oldKey})
+	if err != nil {
+		t.Fatalf("NewLocalKMS() error = %v", err)
+	}
+	rotating, err := crypto.NewLocalKMS("new", map[string][]byte{"old": oldKey, "new": newKey})
+	if err != nil {
+		t.Fatalf("NewLocalKMS() error = %v", err)
+	}
+	retired, err := crypto.NewLocalKMS("new", map[string][]byte{"new": newKey})
+	if err != nil {
+		t.Fatalf("NewLocalKMS() error = %v", err)
+	}
+
+	dob := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	person := &Person{SessionID: "session-1", FullName: "NGUYEN VAN A", IDNumber: "001099012345", DateOfBirth: &dob, Address: "Ha Noi"}
+
+	cipher, wrapped, err := crypto.NewRecordCipher(ctx, before, person.SessionID)
+	if err != nil {
+		t.Fatalf("NewRecordCipher() error = %v", err)
+	}
+	enc, err := encryptFields(cipher, person)
+	if err != nil {
+		t.Fatalf("encryptFields() error = %v", err)
+	}
+
+	if _, err := (&Store{kms: retired}).scanPerson(ctx, personRow(person.SessionID, wrapped, enc)); !errors.Is(err, crypto.ErrUnknownMasterKey) {
+		t.Fatalf("scanPerson() with the old master key retired error = %v, want %v", err, crypto.ErrUnknownMasterKey)
+	}
+
+	rewrapped, changed, err := crypto.Rewrap(ctx, rotating, wrapped)
+	if err != nil || !changed {
+		t.Fatalf("Rewrap() = %v, %v, want a rewrapped key", changed, err)
+	}
+	if rewrapped.MasterKeyID != "new" {
+		t.Errorf("rewrapped master key = %q, want new", rewrapped.MasterKeyID)
+	}
+
+	got, err := (&Store{kms: retired}).scanPerson(ctx, personRow(person.SessionID, rewrapped, enc))
+	if err != nil {
+		t.Fatalf("scanPerson() after rotation error = %v", err)
+	}
+	if got.FullName != person.FullName || got.IDNumber != person.IDNumber || got.Address != person.Address {
+		t.Errorf("scanPerson() after rotation = %+v, want %+v", got, person)
+	}
+	if got.DateOfBirth == nil || !got.DateOfBirth.Equal(dob) {
+		t.Errorf("scanPerson() after rotation dob = %v, want %v", got.DateOfBirth, dob)
+	}
+}
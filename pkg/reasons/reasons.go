@@ -0,0 +1,61 @@
+package reasons
+
+// Reason codes raised while checking a session. Scoring maps them to score
+// penalties; they are also shown to reviewers.
+const (
+	// MRZCheckDigit means an MRZ check digit does not match its field
+	MRZCheckDigit = "MRZ_CHECK_DIGIT"
+	// MRZVisualMismatch means an MRZ field disagrees with the printed visual zone
+	MRZVisualMismatch = "MRZ_VISUAL_MISMATCH"
+	// MRZMissing means a document that must carry an MRZ has none readable
+	MRZMissing = "MRZ_MISSING"
+	// FieldMissing means a field the document profile expects could not be read
+	FieldMissing = "FIELD_MISSING"
+	// IDNumberFormat means the identity number does not match the document's format
+	IDNumberFormat = "ID_NUMBER_FORMAT"
+	// IDNumberProvince means the identity number starts with an unknown province code
+	IDNumberProvince = "ID_NUMBER_PROVINCE"
+	// IDNumberInconsistent means the identity number disagrees with the holder's birth year or sex
+	IDNumberInconsistent = "ID_NUMBER_INCONSISTENT"
+	// DateInvalid means a date could not be read or is implausible
+	DateInvalid = "DATE_INVALID"
+	// DocumentExpired means the document is past its expiry date
+	DocumentExpired = "DOCUMENT_EXPIRED"
+	// FaceNotDetected means no face was found on the document or the selfie
+	FaceNotDetected = "FACE_NOT_DETECTED"
+	// FaceMismatch means the selfie is below the face match threshold of the document
+	FaceMismatch = "FACE_MISMATCH"
+	// LivenessChallengeMissing means a liveness clip was not recorded for an unused challenge
+	LivenessChallengeMissing = "LIVENESS_CHALLENGE_MISSING"
+	// LivenessChallengeExpired means a liveness clip was uploaded after its challenge expired
+	LivenessChallengeExpired = "LIVENESS_CHALLENGE_EXPIRED"
+	// LivenessChallengeMismatch means the clip does not show the challenged actions or digits
+	LivenessChallengeMismatch = "LIVENESS_CHALLENGE_MISMATCH"
+	// LivenessSpoof means the analyzer scored the clip or selfie below the liveness threshold
+	LivenessSpoof = "LIVENESS_SPOOF"
+)
+
+// Reason is a single finding about a session, tied to the field it concerns
+type Reason struct {
+	Code    string `json:"code"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// New creates a reason for a field
+func New(code, field, message string) Reason {
+	return Reason{Code: code, Field: field, Message: message}
+}
+
+// Codes returns the distinct codes of a list of reasons, in order of first appearance
+func Codes(list []Reason) []string {
+	seen := make(map[string]bool, len(list))
+	codes := make([]string, 0, len(list))
+	for _, r := range list {
+		if !seen[r.Code] {
+			seen[r.Code] = true
+			codes = append(codes, r.Code)
+		}
+	}
+	return codes
+}
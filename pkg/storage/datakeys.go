@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// DataKeys persists the wrapped data key of every encrypted object
+type DataKeys struct {
+	db *db.DB
+}
+
+// NewDataKeys creates a new data key store
+func NewDataKeys(database *db.DB) *DataKeys {
+	return &DataKeys{db: database}
+}
+
+// Put stores the wrapped data key of an object
+func (d *DataKeys) Put(ctx context.Context, objectKey string, wrapped crypto.WrappedKey) error {
+	err := d.db.Exec(ctx, `
+		INSERT INTO object_data_keys (s3_key, master_key_id, wrapped_key)
+		VALUES ($1, $2, $3)`,
+		objectKey, wrapped.MasterKeyID, wrapped.Ciphertext,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store data key: %w", err)
+	}
+	return nil
+}
+
+// Get loads the wrapped data key of an object. It returns
+// apperrors.ErrRecordNotFound for objects stored without encryption.
+func (d *DataKeys) Get(ctx context.Context, objectKey string) (crypto.WrappedKey, error) {
+	var wrapped crypto.WrappedKey
+	err := d.db.QueryRow(ctx, `
+		SELECT master_key_id, wrapped_key FROM object_data_keys WHERE s3_key = $1`,
+		objectKey,
+	).Scan(&wrapped.MasterKeyID, &wrapped.Ciphertext)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return crypto.WrappedKey{}, apperrors.ErrRecordNotFound
+		}
+		return crypto.WrappedKey{}, fmt.Errorf("failed to get data key: %w", err)
+	}
+	return wrapped, nil
+}
+
+// Delete removes the data key of an object
+func (d *DataKeys) Delete(ctx context.Context, objectKey string) error {
+	if err := d.db.Exec(ctx, `DELETE FROM object_data_keys WHERE s3_key = $1`, objectKey); err != nil {
+		return fmt.Errorf("failed to delete data key: %w", err)
+	}
+	return nil
+}
+
+// ListStale returns up to limit data keys wrapped under a master key other
+// than masterKeyID, keyed by object key
+func (d *DataKeys) ListStale(ctx context.Context, masterKeyID string, limit int) (map[string]crypto.WrappedKey, error) {
+	rows, err := d.db.Query(ctx, `
+		SELECT s3_key, master_key_id, wrapped_key FROM object_data_keys
+		WHERE master_key_id <> $1
+		ORDER BY s3_key
+		LIMIT $2`,
+		masterKeyID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data keys: %w", err)
+	}
+	defer rows.Close()
+
+	stale := make(map[string]crypto.WrappedKey)
+	for rows.Next() {
+		var (
+			objectKey string
+			wrapped   crypto.WrappedKey
+		)
+		if err := rows.Scan(&objectKey, &wrapped.MasterKeyID, &wrapped.Ciphertext); err != nil {
+			return nil, fmt.Errorf("failed to scan data key: %w", err)
+		}
+		stale[objectKey] = wrapped
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list data keys: %w", err)
+	}
+
+	return stale, nil
+}
+
+// Replace swaps the wrapped data key of an object, provided it is still
+// wrapped under the master key it was read with. It reports whether the row
+// was updated, so concurrent rotations never overwrite each other.
+func (d *DataKeys) Replace(ctx context.Context, objectKey, previousMasterKeyID string, wrapped crypto.WrappedKey) (bool, error) {
+	tag, err := d.db.GetPool().Exec(ctx, `
+		UPDATE object_data_keys
+		SET master_key_id = $3, wrapped_key = $4, rotated_at = NOW()
+		WHERE s3_key = $1 AND master_key_id = $2`,
+		objectKey, previousMasterKeyID, wrapped.MasterKeyID, wrapped.Ciphertext,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to replace data key: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
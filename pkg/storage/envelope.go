@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ekyc-backend/pkg/crypto"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"go.uber.org/zap"
+)
+
+// ErrEncryptionUnavailable is returned when an object is encrypted but no KMS
+// is configured to unwrap its data key
+var ErrEncryptionUnavailable = errors.New("object is encrypted but no KMS is configured")
+
+// EncryptedStore applies envelope encryption to session artifacts. Every
+// object gets its own data key, which MinIO uses for SSE-C and which is kept
+// wrapped under a KMS master key. The bucket never stores a key, and
+// rotating the master key only rewraps data keys, never the objects.
+//
+// Data keys never leave the process: objects are only written and read
+// through this store, so there is no presigned access to encrypted objects.
+//
+// With a nil KMS objects are stored in plaintext. Objects written before
+// encryption was enabled have no data key and stay readable.
+type EncryptedStore struct {
+	objects *MinIO
+	kms     crypto.KMS
+	keys    *DataKeys
+	logger  *logger.Logger
+}
+
+// NewEncryptedStore creates a store encrypting objects with data keys from kms
+func NewEncryptedStore(objects *MinIO, kms crypto.KMS, keys *DataKeys, logger *logger.Logger) *EncryptedStore {
+	return &EncryptedStore{
+		objects: objects,
+		kms:     kms,
+		keys:    keys,
+		logger:  logger,
+	}
+}
+
+// Bucket returns the name of the bucket holding all objects
+func (s *EncryptedStore) Bucket() string {
+	return s.objects.Bucket()
+}
+
+// UploadFile stores a local file under a new data key, with metadata as its
+// user metadata
+func (s *EncryptedStore) UploadFile(ctx context.Context, objectKey, filePath, contentType string, metadata map[string]string) error {
+	sse, err := s.newDataKey(ctx, objectKey)
+	if err != nil {
+		return err
+	}
+	return s.objects.UploadFile(ctx, objectKey, filePath, contentType, metadata, sse)
+}
+
+// GetFile opens an object for reading, decrypting it if needed
+func (s *EncryptedStore) GetFile(ctx context.Context, objectKey string) (io.ReadCloser, error) {
+	sse, err := s.dataKey(ctx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.objects.GetFile(ctx, objectKey, sse)
+}
+
+// GetFileInfo returns the metadata of an object
+func (s *EncryptedStore) GetFileInfo(ctx context.Context, objectKey string) (*minio.ObjectInfo, error) {
+	sse, err := s.dataKey(ctx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.objects.GetFileInfo(ctx, objectKey, sse)
+}
+
+// DeleteFile removes an object and then its data key
+func (s *EncryptedStore) DeleteFile(ctx context.Context, objectKey string) error {
+	if err := s.objects.DeleteFile(ctx, objectKey); err != nil {
+		return err
+	}
+	if s.keys == nil {
+		return nil
+	}
+	return s.keys.Delete(ctx, objectKey)
+}
+
+// RotateDataKeys rewraps up to batchSize data keys that are not wrapped under
+// the primary master key. It returns how many keys were rewrapped; callers
+// repeat until it returns zero.
+func (s *EncryptedStore) RotateDataKeys(ctx context.Context, batchSize int) (int, error) {
+	if s.kms == nil {
+		return 0, ErrEncryptionUnavailable
+	}
+
+	stale, err := s.keys.ListStale(ctx, s.kms.PrimaryKeyID(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for objectKey, wrapped := range stale {
+		rewrapped, changed, err := crypto.Rewrap(ctx, s.kms, wrapped)
+		if err != nil {
+			return rotated, fmt.Errorf("failed to rewrap data key of %s: %w", objectKey, err)
+		}
+		if !changed {
+			continue
+		}
+
+		replaced, err := s.keys.Replace(ctx, objectKey, wrapped.MasterKeyID, rewrapped)
+		if err != nil {
+			return rotated, err
+		}
+		if replaced {
+			rotated++
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("Data keys rewrapped",
+		zap.String("master_key_id", s.kms.PrimaryKeyID()),
+		zap.Int("count", rotated),
+	)
+
+	return rotated, nil
+}
+
+// newDataKey creates and stores a data key for a new object. It returns nil
+// when encryption is disabled.
+func (s *EncryptedStore) newDataKey(ctx context.Context, objectKey string) (encrypt.ServerSide, error) {
+	if s.kms == nil {
+		return nil, nil
+	}
+
+	dataKey, wrapped, err := crypto.GenerateDataKey(ctx, s.kms)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keys.Put(ctx, objectKey, wrapped); err != nil {
+		return nil, err
+	}
+
+	return encrypt.NewSSEC(dataKey)
+}
+
+// dataKey unwraps the data key of an existing object. It returns nil for
+// objects stored without encryption.
+func (s *EncryptedStore) dataKey(ctx context.Context, objectKey string) (encrypt.ServerSide, error) {
+	if s.keys == nil {
+		return nil, nil
+	}
+
+	wrapped, err := s.keys.Get(ctx, objectKey)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if s.kms == nil {
+		return nil, ErrEncryptionUnavailable
+	}
+
+	dataKey, err := s.kms.Unwrap(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	return encrypt.NewSSEC(dataKey)
+}
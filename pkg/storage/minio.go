@@ -2,13 +2,20 @@ package storage
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
 	"time"
 
 	"github.com/ekyc-backend/pkg/config"
 	"github.com/ekyc-backend/pkg/logger"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/minio/minio-go/v7/pkg/notification"
 )
 
 type MinIO struct {
@@ -18,9 +25,28 @@ type MinIO struct {
 }
 
 func NewMinIO(cfg *config.Config, logger *logger.Logger) (*MinIO, error) {
+	transport, err := minio.DefaultTransport(cfg.MinIOUseSSL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MinIO transport: %w", err)
+	}
+
+	// Trust the internal CA so MinIO can be reached over TLS with dev certificates
+	if cfg.MinIOUseSSL && cfg.TLSCAFile != "" {
+		pem, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.TLSCAFile)
+		}
+		transport.TLSClientConfig.RootCAs = roots
+	}
+
 	client, err := minio.New(cfg.MinIOEndpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(cfg.MinIOAccessKeyID, cfg.MinIOSecretAccessKey, ""),
-		Secure: cfg.MinIOUseSSL,
+		Creds:     credentials.NewStaticV4(cfg.MinIOAccessKeyID, cfg.MinIOSecretAccessKey, ""),
+		Secure:    cfg.MinIOUseSSL,
+		Transport: transport,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create MinIO client: %w", err)
@@ -51,6 +77,11 @@ func NewMinIO(cfg *config.Config, logger *logger.Logger) (*MinIO, error) {
 	}, nil
 }
 
+// Bucket returns the name of the bucket holding all objects
+func (m *MinIO) Bucket() string {
+	return m.bucket
+}
+
 func (m *MinIO) GetPresignedPutURL(ctx context.Context, objectKey string, expiration time.Duration) (string, error) {
 	url, err := m.client.PresignedPutObject(ctx, m.bucket, objectKey, expiration)
 	if err != nil {
@@ -59,6 +90,49 @@ func (m *MinIO) GetPresignedPutURL(ctx context.Context, objectKey string, expira
 	return url.String(), nil
 }
 
+// PostPolicy restricts what a browser-based POST upload may write
+type PostPolicy struct {
+	ObjectKey   string
+	ContentType string
+	MaxSize     int64
+	Expiration  time.Duration
+	// Metadata is pinned as user metadata on the uploaded object
+	Metadata map[string]string
+}
+
+// GetPresignedPostPolicy signs a POST policy pinning the object key, content
+// type and maximum size. It returns the upload URL and the form fields the
+// client must submit alongside the file.
+func (m *MinIO) GetPresignedPostPolicy(ctx context.Context, p PostPolicy) (string, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(m.bucket); err != nil {
+		return "", nil, fmt.Errorf("failed to set policy bucket: %w", err)
+	}
+	if err := policy.SetKey(p.ObjectKey); err != nil {
+		return "", nil, fmt.Errorf("failed to set policy key: %w", err)
+	}
+	if err := policy.SetContentType(p.ContentType); err != nil {
+		return "", nil, fmt.Errorf("failed to set policy content type: %w", err)
+	}
+	if err := policy.SetContentLengthRange(1, p.MaxSize); err != nil {
+		return "", nil, fmt.Errorf("failed to set policy content length: %w", err)
+	}
+	if err := policy.SetExpires(time.Now().UTC().Add(p.Expiration)); err != nil {
+		return "", nil, fmt.Errorf("failed to set policy expiration: %w", err)
+	}
+	for key, value := range p.Metadata {
+		if err := policy.SetUserMetadata(key, value); err != nil {
+			return "", nil, fmt.Errorf("failed to set policy metadata %s: %w", key, err)
+		}
+	}
+
+	url, formData, err := m.client.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate presigned POST policy: %w", err)
+	}
+	return url.String(), formData, nil
+}
+
 func (m *MinIO) GetPresignedGetURL(ctx context.Context, objectKey string, expiration time.Duration) (string, error) {
 	url, err := m.client.PresignedGetObject(ctx, m.bucket, objectKey, expiration, nil)
 	if err != nil {
@@ -67,9 +141,55 @@ func (m *MinIO) GetPresignedGetURL(ctx context.Context, objectKey string, expira
 	return url.String(), nil
 }
 
-func (m *MinIO) UploadFile(ctx context.Context, objectKey string, filePath string, contentType string) error {
+// EnableNotifications subscribes the notification target identified by arn
+// to object-created events under prefix. Targets are configured on the MinIO
+// server; this only attaches one to the bucket and is safe to call repeatedly.
+func (m *MinIO) EnableNotifications(ctx context.Context, arn, prefix string) error {
+	target, err := notification.NewArnFromString(arn)
+	if err != nil {
+		return fmt.Errorf("invalid notification ARN %q: %w", arn, err)
+	}
+
+	current, err := m.client.GetBucketNotification(ctx, m.bucket)
+	if err != nil {
+		return fmt.Errorf("failed to get bucket notifications: %w", err)
+	}
+
+	for _, existing := range current.QueueConfigs {
+		if existing.Queue == target.String() && hasPrefixFilter(existing.Filter, prefix) {
+			return nil
+		}
+	}
+
+	queue := notification.NewConfig(target)
+	queue.AddEvents(notification.ObjectCreatedAll)
+	queue.AddFilterPrefix(prefix)
+	current.AddQueue(queue)
+
+	if err := m.client.SetBucketNotification(ctx, m.bucket, current); err != nil {
+		return fmt.Errorf("failed to set bucket notifications: %w", err)
+	}
+	return nil
+}
+
+// hasPrefixFilter reports whether filter restricts events to exactly prefix
+func hasPrefixFilter(filter *notification.Filter, prefix string) bool {
+	if filter == nil {
+		return false
+	}
+	for _, rule := range filter.S3Key.FilterRules {
+		if rule.Name == "prefix" && rule.Value == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MinIO) UploadFile(ctx context.Context, objectKey string, filePath string, contentType string, metadata map[string]string, sse encrypt.ServerSide) error {
 	_, err := m.client.FPutObject(ctx, m.bucket, objectKey, filePath, minio.PutObjectOptions{
-		ContentType: contentType,
+		ContentType:          contentType,
+		UserMetadata:         metadata,
+		ServerSideEncryption: sse,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to upload file: %w", err)
@@ -85,10 +205,10 @@ func (m *MinIO) DeleteFile(ctx context.Context, objectKey string) error {
 	return nil
 }
 
-func (m *MinIO) FileExists(ctx context.Context, objectKey string) (bool, error) {
-	_, err := m.client.StatObject(ctx, m.bucket, objectKey, minio.StatObjectOptions{})
+func (m *MinIO) FileExists(ctx context.Context, objectKey string, sse encrypt.ServerSide) (bool, error) {
+	_, err := m.client.StatObject(ctx, m.bucket, objectKey, minio.StatObjectOptions{ServerSideEncryption: sse})
 	if err != nil {
-		if err.Error() == "The specified key does not exist." {
+		if IsObjectNotFound(err) {
 			return false, nil
 		}
 		return false, fmt.Errorf("failed to check file existence: %w", err)
@@ -96,10 +216,31 @@ func (m *MinIO) FileExists(ctx context.Context, objectKey string) (bool, error)
 	return true, nil
 }
 
-func (m *MinIO) GetFileInfo(ctx context.Context, objectKey string) (*minio.ObjectInfo, error) {
-	info, err := m.client.StatObject(ctx, m.bucket, objectKey, minio.StatObjectOptions{})
+func (m *MinIO) GetFileInfo(ctx context.Context, objectKey string, sse encrypt.ServerSide) (*minio.ObjectInfo, error) {
+	info, err := m.client.StatObject(ctx, m.bucket, objectKey, minio.StatObjectOptions{
+		ServerSideEncryption: sse,
+		Checksum:             true,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file info: %w", err)
 	}
 	return &info, nil
 }
+
+// GetFile opens an object for reading. The caller must close the reader.
+func (m *MinIO) GetFile(ctx context.Context, objectKey string, sse encrypt.ServerSide) (io.ReadCloser, error) {
+	object, err := m.client.GetObject(ctx, m.bucket, objectKey, minio.GetObjectOptions{ServerSideEncryption: sse})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	return object, nil
+}
+
+// IsObjectNotFound reports whether err signals a missing object
+func IsObjectNotFound(err error) bool {
+	var resp minio.ErrorResponse
+	if errors.As(err, &resp) {
+		return resp.Code == "NoSuchKey" || resp.StatusCode == http.StatusNotFound
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,11 +17,25 @@ type Redis struct {
 }
 
 func NewRedis(cfg *config.Config, logger *logger.Logger) (*Redis, error) {
-	client := redis.NewClient(&redis.Options{
+	return newRedis(&redis.Options{
 		Addr:     cfg.GetRedisAddr(),
 		Password: cfg.RedisPassword,
 		DB:       cfg.RedisDB,
-	})
+	}, logger)
+}
+
+// NewRedisFromURL connects to the Redis server at a redis:// or rediss:// URL
+func NewRedisFromURL(url string, logger *logger.Logger) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %w", err)
+	}
+	return newRedis(opts, logger)
+}
+
+// newRedis creates a client and checks the connection
+func newRedis(opts *redis.Options, logger *logger.Logger) (*Redis, error) {
+	client := redis.NewClient(opts)
 
 	// Test connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -46,6 +61,16 @@ func (r *Redis) Get(ctx context.Context, key string) (string, error) {
 	return r.client.Get(ctx, key).Result()
 }
 
+// GetDel returns the value of key and deletes it in one step
+func (r *Redis) GetDel(ctx context.Context, key string) (string, error) {
+	return r.client.GetDel(ctx, key).Result()
+}
+
+// IsNil reports whether err signals a missing key
+func IsNil(err error) bool {
+	return errors.Is(err, redis.Nil)
+}
+
 func (r *Redis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
 	return r.client.Set(ctx, key, value, expiration).Err()
 }
@@ -54,6 +79,32 @@ func (r *Redis) SetNX(ctx context.Context, key string, value interface{}, expira
 	return r.client.SetNX(ctx, key, value, expiration).Result()
 }
 
+// setIfNotLess sets KEYS[1] to the integer ARGV[1] unless it holds a greater
+// integer. The key expires after ARGV[2] milliseconds, or never if that is 0.
+var setIfNotLess = redis.NewScript(`
+local current = tonumber(redis.call('GET', KEYS[1]))
+if current and current > tonumber(ARGV[1]) then
+	return 0
+end
+if tonumber(ARGV[2]) > 0 then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+else
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// SetMax sets key to value unless it already holds a greater integer, in one
+// step, so concurrent writers keep the greatest value. It reports whether
+// the key was set; setting an equal value renews its expiration.
+func (r *Redis) SetMax(ctx context.Context, key string, value int64, expiration time.Duration) (bool, error) {
+	set, err := setIfNotLess.Run(ctx, r.client, []string{key}, value, expiration.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return set == 1, nil
+}
+
 func (r *Redis) Del(ctx context.Context, keys ...string) error {
 	return r.client.Del(ctx, keys...).Err()
 }
@@ -5,6 +5,12 @@ go 1.22
 require (
 	github.com/ekyc-backend/pkg v0.0.0
 	github.com/gin-gonic/gin v1.9.1
+	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.5.3
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.48.0
+	go.uber.org/zap v1.26.0
+	google.golang.org/grpc v1.61.0
+	google.golang.org/protobuf v1.32.0
 )
 
 replace github.com/ekyc-backend/pkg => ../../pkg
@@ -0,0 +1,71 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// IdentityClient handles gRPC communication with the identity service
+type IdentityClient struct {
+	client proto.IdentityServiceClient
+	conn   *grpc.ClientConn
+	logger *logger.Logger
+	addr   string
+}
+
+// NewIdentityClient creates a new identity service client. Calls carry the
+// trace context and the admin's access token, so the identity service
+// authorizes the admin rather than this service.
+func NewIdentityClient(addr string, tlsSource *mtls.Source, logger *logger.Logger) (*IdentityClient, error) {
+	conn, err := grpc.Dial(addr,
+		mtls.DialOption(tlsSource),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(forwardToken()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to identity service: %w", err)
+	}
+
+	return &IdentityClient{
+		client: proto.NewIdentityServiceClient(conn),
+		conn:   conn,
+		logger: logger,
+		addr:   addr,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *IdentityClient) Close() error {
+	return c.conn.Close()
+}
+
+// ApplyAdminDecision approves or rejects a session under review
+func (c *IdentityClient) ApplyAdminDecision(ctx context.Context, req *proto.ApplyAdminDecisionRequest) (*proto.ApplyAdminDecisionResponse, error) {
+	resp, err := c.client.ApplyAdminDecision(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply admin decision: %w", err)
+	}
+
+	return resp, nil
+}
+
+// forwardToken returns a client interceptor that forwards the access token
+// of the incoming call
+func forwardToken() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for _, value := range md.Get("authorization") {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", value)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// Session is the admin view of an eKYC session
+type Session struct {
+	ID           string
+	UserID       string
+	Status       string
+	Score        *int32
+	PendingSteps []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Artifact is an object uploaded to a session
+type Artifact struct {
+	Type       string
+	Key        string
+	UploadedAt time.Time
+}
+
+// SessionFilter selects the sessions to list. Query matches a session ID,
+// a user ID or part of the user's email.
+type SessionFilter struct {
+	Status string
+	Query  string
+}
+
+// SessionRepository reads eKYC sessions for review. Sessions are written by
+// the identity service; this repository never modifies them.
+type SessionRepository struct {
+	db *db.DB
+}
+
+// NewSessionRepository creates a new session repository
+func NewSessionRepository(database *db.DB) *SessionRepository {
+	return &SessionRepository{db: database}
+}
+
+const sessionColumns = `s.id::text, COALESCE(s.user_id::text, ''), s.status::text, s.score, s.pending_steps, s.created_at, s.updated_at`
+
+// sessionFilter is the WHERE clause applied to sessions s joined with users u
+const sessionFilter = `
+	WHERE ($1 = '' OR s.status::text = $1)
+	  AND ($2 = '' OR s.id::text = $2 OR s.user_id::text = $2 OR u.email ILIKE '%' || $2 || '%')`
+
+// List returns a page of sessions matching filter, most recently updated
+// first, and the total number of matching sessions
+func (r *SessionRepository) List(ctx context.Context, filter SessionFilter, limit, offset int) ([]*Session, int, error) {
+	var total int
+	err := r.db.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM ekyc_sessions s
+		LEFT JOIN users u ON u.id = s.user_id`+sessionFilter,
+		filter.Status, filter.Query,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count sessions: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+sessionColumns+`
+		FROM ekyc_sessions s
+		LEFT JOIN users u ON u.id = s.user_id`+sessionFilter+`
+		ORDER BY s.updated_at DESC, s.id
+		LIMIT $3 OFFSET $4`,
+		filter.Status, filter.Query, limit, offset,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*Session{}
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read sessions: %w", err)
+	}
+
+	return sessions, total, nil
+}
+
+// Get returns a session by ID
+func (r *SessionRepository) Get(ctx context.Context, sessionID string) (*Session, error) {
+	row := r.db.QueryRow(ctx, `SELECT `+sessionColumns+` FROM ekyc_sessions s WHERE s.id = $1`, sessionID)
+
+	s, err := scanSession(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return s, nil
+}
+
+// Artifacts returns the objects uploaded to a session in upload order
+func (r *SessionRepository) Artifacts(ctx context.Context, sessionID string) ([]*Artifact, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT type::text, s3_key, created_at
+		FROM ekyc_artifacts
+		WHERE session_id = $1
+		ORDER BY created_at, id`,
+		sessionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+	defer rows.Close()
+
+	var artifacts []*Artifact
+	for rows.Next() {
+		var a Artifact
+		if err := rows.Scan(&a.Type, &a.Key, &a.UploadedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan artifact: %w", err)
+		}
+		artifacts = append(artifacts, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read artifacts: %w", err)
+	}
+
+	return artifacts, nil
+}
+
+// scanSession scans a row selected with sessionColumns
+func scanSession(row pgx.Row) (*Session, error) {
+	var (
+		s     Session
+		steps []byte
+	)
+
+	if err := row.Scan(&s.ID, &s.UserID, &s.Status, &s.Score, &steps, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	s.PendingSteps = []string{}
+	if len(steps) > 0 {
+		if err := json.Unmarshal(steps, &s.PendingSteps); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pending steps: %w", err)
+		}
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/admin/internal/clients"
+	"github.com/ekyc-backend/services/admin/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	// DefaultPageSize is the page size used when a request sets none
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page of sessions returned at once
+	MaxPageSize = 100
+)
+
+// AdminServer implements the AdminService gRPC API. Sessions are read from
+// the database; decisions are applied by the identity service, which owns
+// the session state machine.
+type AdminServer struct {
+	proto.UnimplementedAdminServiceServer
+
+	sessions *repository.SessionRepository
+	identity *clients.IdentityClient
+	logger   *logger.Logger
+}
+
+// NewAdminServer creates a new admin gRPC server
+func NewAdminServer(sessions *repository.SessionRepository, identity *clients.IdentityClient, logger *logger.Logger) *AdminServer {
+	return &AdminServer{
+		sessions: sessions,
+		identity: identity,
+		logger:   logger,
+	}
+}
+
+// ListSessions returns a page of sessions, most recently updated first
+func (s *AdminServer) ListSessions(ctx context.Context, req *proto.ListSessionsRequest) (*proto.SessionListResponse, error) {
+	page, size := req.GetPage(), req.GetSize()
+	if page < 0 || size < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page and size must not be negative")
+	}
+	if page == 0 {
+		page = 1
+	}
+	if size == 0 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	filter := repository.SessionFilter{Query: req.GetFilter().GetQuery()}
+	if st := req.GetFilter().GetStatus(); st != proto.SessionStatus_SESSION_STATUS_UNSPECIFIED {
+		filter.Status = st.String()
+	}
+
+	sessions, total, err := s.sessions.List(ctx, filter, int(size), int(page-1)*int(size))
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	resp := &proto.SessionListResponse{
+		Sessions: make([]*proto.SessionSummary, 0, len(sessions)),
+		Total:    int32(total),
+		Page:     page,
+		Size:     size,
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &proto.SessionSummary{
+			Id:        session.ID,
+			UserId:    session.UserID,
+			Status:    toProtoStatus(session.Status),
+			Score:     score(session),
+			CreatedAt: timestamppb.New(session.CreatedAt),
+			UpdatedAt: timestamppb.New(session.UpdatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetSessionDetail returns a session with its uploaded artifacts. The latest
+// selfie and liveness clip are reported; every document is listed.
+func (s *AdminServer) GetSessionDetail(ctx context.Context, req *proto.GetSessionDetailRequest) (*proto.SessionDetailResponse, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	session, err := s.sessions.Get(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	artifacts, err := s.sessions.Artifacts(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	detail := &proto.SessionDetail{
+		Id:           session.ID,
+		UserId:       session.UserID,
+		Status:       toProtoStatus(session.Status),
+		Score:        score(session),
+		PendingSteps: session.PendingSteps,
+		Documents:    []*proto.ArtifactInfo{},
+		CreatedAt:    timestamppb.New(session.CreatedAt),
+		UpdatedAt:    timestamppb.New(session.UpdatedAt),
+	}
+	for _, artifact := range artifacts {
+		info := &proto.ArtifactInfo{
+			Type:       proto.ArtifactType(proto.ArtifactType_value[artifact.Type]),
+			Key:        artifact.Key,
+			UploadedAt: timestamppb.New(artifact.UploadedAt),
+		}
+		switch info.Type {
+		case proto.ArtifactType_DOC_FRONT, proto.ArtifactType_DOC_BACK, proto.ArtifactType_PASSPORT:
+			detail.Documents = append(detail.Documents, info)
+		case proto.ArtifactType_SELFIE:
+			detail.Selfie = info
+		case proto.ArtifactType_LIVENESS_CLIP:
+			detail.Liveness = info
+		}
+	}
+
+	return &proto.SessionDetailResponse{Session: detail}, nil
+}
+
+// ApplyDecision approves or rejects a session under review on behalf of the
+// calling admin. The decision is recorded against the admin named in the
+// access token; admin_id may be omitted but must otherwise name the caller.
+func (s *AdminServer) ApplyDecision(ctx context.Context, req *proto.ApplyDecisionRequest) (*proto.ApplyDecisionResponse, error) {
+	claims, ok := grpcmw.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+	if req.GetAdminId() != "" && req.GetAdminId() != claims.UserID {
+		return nil, status.Error(codes.PermissionDenied, "admin_id does not match the caller")
+	}
+
+	resp, err := s.identity.ApplyAdminDecision(ctx, &proto.ApplyAdminDecisionRequest{
+		SessionId: req.GetSessionId(),
+		Decision:  req.GetDecision(),
+		Note:      req.GetNote(),
+		DecidedBy: claims.UserID,
+	})
+	if err != nil {
+		// Identity errors are already status errors for the caller
+		var se interface{ GRPCStatus() *status.Status }
+		if errors.As(err, &se) {
+			return nil, se.GRPCStatus().Err()
+		}
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	s.logger.WithContext(ctx).WithSessionID(resp.GetSessionId()).Info("Admin decision applied",
+		zap.String("decision", resp.GetDecision().String()),
+		zap.String("decided_by", claims.UserID),
+	)
+
+	return &proto.ApplyDecisionResponse{
+		SessionId: resp.GetSessionId(),
+		Decision:  resp.GetDecision(),
+		Note:      resp.GetNote(),
+		DecidedBy: claims.UserID,
+		DecidedAt: resp.GetDecidedAt(),
+	}, nil
+}
+
+// toStatusError maps repository errors to gRPC status errors
+func (s *AdminServer) toStatusError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, apperrors.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "session not found")
+	default:
+		s.logger.WithContext(ctx).Error("Admin request failed", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// toProtoStatus converts a stored session status to its proto value
+func toProtoStatus(s string) proto.SessionStatus {
+	return proto.SessionStatus(proto.SessionStatus_value[s])
+}
+
+// score returns a session's score, or 0 if it has not been scored
+func score(s *repository.Session) int32 {
+	if s.Score == nil {
+		return 0
+	}
+	return *s.Score
+}
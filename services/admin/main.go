@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/admin/internal/clients"
+	"github.com/ekyc-backend/services/admin/internal/repository"
+	"github.com/ekyc-backend/services/admin/internal/server"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Admin service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize Redis for the token denylist written by the gateway
+	redisClient, err := storage.NewRedis(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to Redis", zap.Error(err))
+	}
+	defer redisClient.Close()
+
+	// Initialize token verification against the gateway's published keys and
+	// revocations
+	verifier := jwtkeys.NewVerifier(jwtkeys.NewRemoteKeySet(cfg.JWKSURL, jwtkeys.DefaultJWKSRefreshInterval), cfg.JWTIssuer)
+	denylist := jwtkeys.NewDenylist(redisClient)
+	authPolicy := grpcmw.NewAuthPolicy().
+		RequireAnyRole("/ekyc.AdminService/ListSessions", "ADMIN").
+		RequireAnyRole("/ekyc.AdminService/GetSessionDetail", "ADMIN").
+		RequireAnyRole("/ekyc.AdminService/ApplyDecision", "ADMIN")
+
+	// Initialize mTLS for service-to-service traffic
+	tlsSource, err := mtls.Load(mtls.OptionsFromConfig(cfg), log)
+	if err != nil {
+		log.Fatal("Failed to load mTLS configuration", zap.Error(err))
+	}
+	if tlsSource != nil {
+		defer tlsSource.Close()
+	}
+
+	// Decisions are applied by the identity service with the admin's token
+	identityClient, err := clients.NewIdentityClient(cfg.IdentityGRPCAddr, tlsSource, log)
+	if err != nil {
+		log.Fatal("Failed to create identity client", zap.Error(err))
+	}
+	defer identityClient.Close()
+
+	// Only the gateway calls this service
+	peers := mtls.NewPeerPolicy(cfg.TLSTrustDomain).Allow("api-gateway")
+
+	// Initialize gRPC server
+	serverOptions := append(mtls.ServerOptions(tlsSource, peers),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryTracingInterceptor(cfg.ServiceName),
+			grpcmw.UnaryLoggingInterceptor(log),
+			grpcmw.UnaryAuthInterceptor(verifier, denylist, authPolicy, log),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamTracingInterceptor(cfg.ServiceName),
+			grpcmw.StreamLoggingInterceptor(log),
+			grpcmw.StreamAuthInterceptor(verifier, denylist, authPolicy, log),
+		),
+	)
+	grpcServer := grpc.NewServer(serverOptions...)
+	proto.RegisterAdminServiceServer(grpcServer, server.NewAdminServer(repository.NewSessionRepository(database), identityClient, log))
+
+	listener, err := net.Listen("tcp", cfg.GetGRPCAddr())
+	if err != nil {
+		log.Fatal("Failed to listen for gRPC", zap.Error(err))
+	}
+
+	go func() {
+		log.Info("Starting gRPC server", zap.String("addr", cfg.GetGRPCAddr()))
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	// Initialize HTTP server for health checks
+	httpServer := server.NewHTTPServer(cfg)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcServer.GracefulStop()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}
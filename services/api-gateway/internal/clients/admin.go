@@ -6,9 +6,8 @@ import (
 	"time"
 
 	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
 
 	"github.com/ekyc-backend/pkg/contracts/proto"
 )
@@ -22,8 +21,8 @@ type AdminClient struct {
 }
 
 // NewAdminClient creates a new admin service client
-func NewAdminClient(addr string, logger *logger.Logger) (*AdminClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewAdminClient(addr string, tlsSource *mtls.Source, logger *logger.Logger) (*AdminClient, error) {
+	conn, err := grpc.Dial(addr, dialOptions(tlsSource)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to admin service: %w", err)
 	}
@@ -53,10 +52,6 @@ func (c *AdminClient) ListSessions(ctx context.Context, filter *proto.SessionFil
 
 	resp, err := c.client.ListSessions(ctx, req)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if ok {
-			return nil, fmt.Errorf("admin service error: %s", st.Message())
-		}
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
 
@@ -71,10 +66,6 @@ func (c *AdminClient) GetSessionDetail(ctx context.Context, sessionID string) (*
 
 	resp, err := c.client.GetSessionDetail(ctx, req)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if ok {
-			return nil, fmt.Errorf("admin service error: %s", st.Message())
-		}
 		return nil, fmt.Errorf("failed to get session detail: %w", err)
 	}
 
@@ -82,24 +73,20 @@ func (c *AdminClient) GetSessionDetail(ctx context.Context, sessionID string) (*
 }
 
 // ApplyDecision applies an admin decision to a session
-func (c *AdminClient) ApplyDecision(ctx context.Context, sessionID, status, note, adminID string) error {
+func (c *AdminClient) ApplyDecision(ctx context.Context, sessionID string, decision proto.DecisionStatus, note, adminID string) (*proto.ApplyDecisionResponse, error) {
 	req := &proto.ApplyDecisionRequest{
 		SessionId: sessionID,
-		Status:    status,
+		Decision:  decision,
 		Note:      note,
 		AdminId:   adminID,
 	}
 
-	_, err := c.client.ApplyDecision(ctx, req)
+	resp, err := c.client.ApplyDecision(ctx, req)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if ok {
-			return fmt.Errorf("admin service error: %s", st.Message())
-		}
-		return fmt.Errorf("failed to apply decision: %w", err)
+		return nil, fmt.Errorf("failed to apply decision: %w", err)
 	}
 
-	return nil
+	return resp, nil
 }
 
 // HealthCheck checks if the admin service is healthy
@@ -108,11 +95,5 @@ func (c *AdminClient) HealthCheck(ctx context.Context) error {
 	defer cancel()
 
 	_, err := c.client.GetSessionDetail(ctx, &proto.GetSessionDetailRequest{SessionId: "health-check"})
-	if err != nil {
-		// For health check, we don't care about the specific error
-		// just that the service is reachable
-		return fmt.Errorf("admin service health check failed: %w", err)
-	}
-
-	return nil
+	return checkReachable("admin", err)
 }
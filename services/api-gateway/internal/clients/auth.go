@@ -0,0 +1,20 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// forwardToken returns a client interceptor that forwards the caller's access
+// token so downstream services can authorize the request themselves
+func forwardToken() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if token := middleware.GetAccessToken(ctx); token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", middleware.BearerPrefix+token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,30 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/ekyc-backend/pkg/mtls"
+	ekycotel "github.com/ekyc-backend/pkg/otel"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// dialOptions returns the options every backend connection uses: mTLS, a
+// client span per call carrying the trace context and baggage, and the
+// caller's access token
+func dialOptions(tlsSource *mtls.Source) []grpc.DialOption {
+	return []grpc.DialOption{
+		mtls.DialOption(tlsSource),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(forwardIDs(), forwardToken()),
+	}
+}
+
+// forwardIDs returns a client interceptor that adds the request's
+// correlation ID to the baggage sent downstream, so services and the events
+// they publish keep it
+func forwardIDs() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(ekycotel.IDsToBaggage(ctx), method, req, reply, cc, opts...)
+	}
+}
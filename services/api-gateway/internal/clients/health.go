@@ -0,0 +1,25 @@
+package clients
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkReachable interprets the result of a health probe RPC. Probes use
+// placeholder arguments, so application errors such as InvalidArgument or
+// Unauthenticated still prove the service answered; only transport-level
+// failures mark it unhealthy.
+func checkReachable(service string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Unimplemented:
+		return fmt.Errorf("%s service health check failed: %w", service, err)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// LivenessClient handles gRPC communication with the liveness service
+type LivenessClient struct {
+	client proto.LivenessServiceClient
+	conn   *grpc.ClientConn
+	logger *logger.Logger
+	addr   string
+}
+
+// NewLivenessClient creates a new liveness service client
+func NewLivenessClient(addr string, tlsSource *mtls.Source, logger *logger.Logger) (*LivenessClient, error) {
+	conn, err := grpc.Dial(addr, dialOptions(tlsSource)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to liveness service: %w", err)
+	}
+
+	client := proto.NewLivenessServiceClient(conn)
+
+	return &LivenessClient{
+		client: client,
+		conn:   conn,
+		logger: logger,
+		addr:   addr,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *LivenessClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetChallenge returns the open liveness challenge of a session, issuing one
+// if needed
+func (c *LivenessClient) GetChallenge(ctx context.Context, sessionID string) (*proto.LivenessChallenge, error) {
+	resp, err := c.client.GetChallenge(ctx, &proto.GetLivenessChallengeRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get liveness challenge: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,53 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// ScoringClient handles gRPC communication with the scoring service
+type ScoringClient struct {
+	client proto.ScoringServiceClient
+	conn   *grpc.ClientConn
+	logger *logger.Logger
+	addr   string
+}
+
+// NewScoringClient creates a new scoring service client
+func NewScoringClient(addr string, tlsSource *mtls.Source, logger *logger.Logger) (*ScoringClient, error) {
+	conn, err := grpc.Dial(addr, dialOptions(tlsSource)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to scoring service: %w", err)
+	}
+
+	client := proto.NewScoringServiceClient(conn)
+
+	return &ScoringClient{
+		client: client,
+		conn:   conn,
+		logger: logger,
+		addr:   addr,
+	}, nil
+}
+
+// Close closes the gRPC connection
+func (c *ScoringClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetShadowReport compares the active scoring ruleset with the candidate
+// rulesets evaluated in shadow
+func (c *ScoringClient) GetShadowReport(ctx context.Context, req *proto.ShadowReportRequest) (*proto.ShadowReport, error) {
+	resp, err := c.client.GetShadowReport(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shadow report: %w", err)
+	}
+
+	return resp, nil
+}
@@ -6,9 +6,8 @@ import (
 	"time"
 
 	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
 
 	"github.com/ekyc-backend/pkg/contracts/proto"
 )
@@ -22,8 +21,8 @@ type StorageClient struct {
 }
 
 // NewStorageClient creates a new storage service client
-func NewStorageClient(addr string, logger *logger.Logger) (*StorageClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewStorageClient(addr string, tlsSource *mtls.Source, logger *logger.Logger) (*StorageClient, error) {
+	conn, err := grpc.Dial(addr, dialOptions(tlsSource)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to storage service: %w", err)
 	}
@@ -43,39 +42,51 @@ func (c *StorageClient) Close() error {
 	return c.conn.Close()
 }
 
-// GetPresignedPut generates a presigned PUT URL for file upload
-func (c *StorageClient) GetPresignedPut(ctx context.Context, key, contentType string, expiresIn time.Duration) (*proto.PresignedURLResponse, error) {
-	req := &proto.GetPresignedPutRequest{
-		Key:         key,
-		ContentType: contentType,
-		ExpiresIn:   int32(expiresIn.Seconds()),
+// GetPresignedPostPolicy requests a POST upload policy for a new artifact of a session.
+// The storage service chooses the object key and pins its content type, size,
+// document type and checksum.
+func (c *StorageClient) GetPresignedPostPolicy(ctx context.Context, sessionID string, artifactType proto.ArtifactType, documentType proto.DocumentType, contentType, checksum string, expiresIn time.Duration) (*proto.GetPresignedPostPolicyResponse, error) {
+	req := &proto.GetPresignedPostPolicyRequest{
+		SessionId:         sessionID,
+		ArtifactType:      artifactType,
+		DocumentType:      documentType,
+		ContentType:       contentType,
+		ChecksumSha256:    checksum,
+		ExpirationSeconds: int32(expiresIn.Seconds()),
 	}
 
-	resp, err := c.client.GetPresignedPut(ctx, req)
+	resp, err := c.client.GetPresignedPostPolicy(ctx, req)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if ok {
-			return nil, fmt.Errorf("storage service error: %s", st.Message())
-		}
-		return nil, fmt.Errorf("failed to get presigned PUT URL: %w", err)
+		return nil, fmt.Errorf("failed to get presigned POST policy: %w", err)
 	}
 
 	return resp, nil
 }
 
-// GetPresignedGet generates a presigned GET URL for file download
-func (c *StorageClient) GetPresignedGet(ctx context.Context, key string, expiresIn time.Duration) (*proto.PresignedURLResponse, error) {
-	req := &proto.GetPresignedGetRequest{
-		Key:       key,
-		ExpiresIn: int32(expiresIn.Seconds()),
+// ConfirmUpload asks the storage service to verify an uploaded object and record it as an artifact
+func (c *StorageClient) ConfirmUpload(ctx context.Context, sessionID, key string) (*proto.ConfirmUploadResponse, error) {
+	req := &proto.ConfirmUploadRequest{
+		SessionId: sessionID,
+		ObjectKey: key,
 	}
 
-	resp, err := c.client.GetPresignedGet(ctx, req)
+	resp, err := c.client.ConfirmUpload(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm upload: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetPresignedGetURL generates a presigned GET URL for downloading an artifact
+func (c *StorageClient) GetPresignedGetURL(ctx context.Context, key string, expiresIn time.Duration) (*proto.GetPresignedGetURLResponse, error) {
+	req := &proto.GetPresignedGetURLRequest{
+		ObjectKey:         key,
+		ExpirationSeconds: int32(expiresIn.Seconds()),
+	}
+
+	resp, err := c.client.GetPresignedGetURL(ctx, req)
 	if err != nil {
-		st, ok := status.FromError(err)
-		if ok {
-			return nil, fmt.Errorf("storage service error: %s", st.Message())
-		}
 		return nil, fmt.Errorf("failed to get presigned GET URL: %w", err)
 	}
 
@@ -87,12 +98,6 @@ func (c *StorageClient) HealthCheck(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	_, err := c.client.GetPresignedGet(ctx, &proto.GetPresignedGetRequest{Key: "health-check"})
-	if err != nil {
-		// For health check, we don't care about the specific error
-		// just that the service is reachable
-		return fmt.Errorf("storage service health check failed: %w", err)
-	}
-
-	return nil
+	_, err := c.client.GetPresignedGetURL(ctx, &proto.GetPresignedGetURLRequest{ObjectKey: "health-check"})
+	return checkReachable("storage", err)
 }
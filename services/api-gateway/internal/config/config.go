@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	pkgconfig "github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/mtls"
 	"github.com/spf13/viper"
 )
 
@@ -18,7 +20,11 @@ type Config struct {
 	AllowOrigins []string
 
 	// JWT configuration
-	JWTSecret string
+	JWTSigningKeyFile      string
+	JWTSigningKeyID        string
+	JWTVerificationKeysDir string
+	AccessTokenTTL         time.Duration
+	RefreshTokenTTL        time.Duration
 
 	// Rate limiting
 	RateLimitRPS   int
@@ -27,10 +33,18 @@ type Config struct {
 	// Redis configuration
 	RedisURL string
 
+	// mTLS for gRPC clients
+	TLSCAFile         string
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSReloadInterval time.Duration
+
 	// gRPC service addresses
 	IdentityGRPCAddr string
 	StorageGRPCAddr  string
 	AdminGRPCAddr    string
+	LivenessGRPCAddr string
+	ScoringGRPCAddr  string
 
 	// OpenTelemetry configuration
 	OTELExporterOTLPEndpoint string
@@ -48,13 +62,23 @@ func Load() (*Config, error) {
 	viper.SetDefault("SERVICE_NAME", "api-gateway")
 	viper.SetDefault("HTTP_PORT", 8080)
 	viper.SetDefault("ALLOW_ORIGINS", "*")
-	viper.SetDefault("JWT_SECRET", "changeme")
+	viper.SetDefault("JWT_SIGNING_KEY_FILE", "")
+	viper.SetDefault("JWT_SIGNING_KEY_ID", "")
+	viper.SetDefault("JWT_VERIFICATION_KEYS_DIR", "")
+	viper.SetDefault("ACCESS_TOKEN_TTL", "15m")
+	viper.SetDefault("REFRESH_TOKEN_TTL", "720h")
 	viper.SetDefault("RATE_LIMIT_RPS", 10)
 	viper.SetDefault("RATE_LIMIT_BURST", 20)
 	viper.SetDefault("REDIS_URL", "redis://redis:6379")
+	viper.SetDefault("TLS_CA_FILE", "")
+	viper.SetDefault("TLS_CERT_FILE", "")
+	viper.SetDefault("TLS_KEY_FILE", "")
+	viper.SetDefault("TLS_RELOAD_INTERVAL", "30s")
 	viper.SetDefault("IDENTITY_GRPC_ADDR", "identity:9090")
 	viper.SetDefault("STORAGE_GRPC_ADDR", "storage-svc:9092")
 	viper.SetDefault("ADMIN_GRPC_ADDR", "admin:9093")
+	viper.SetDefault("LIVENESS_GRPC_ADDR", "liveness:9094")
+	viper.SetDefault("SCORING_GRPC_ADDR", "scoring:9095")
 	viper.SetDefault("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4317")
 	viper.SetDefault("PROMETHEUS_METRICS_PATH", "/metrics")
 	viper.SetDefault("MAX_REQUEST_BODY_SIZE", 2*1024*1024) // 2MB
@@ -82,17 +106,43 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid REQUEST_TIMEOUT: %w", err)
 	}
 
+	// Parse token lifetimes
+	accessTokenTTL, err := time.ParseDuration(viper.GetString("ACCESS_TOKEN_TTL"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ACCESS_TOKEN_TTL: %w", err)
+	}
+
+	refreshTokenTTL, err := time.ParseDuration(viper.GetString("REFRESH_TOKEN_TTL"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REFRESH_TOKEN_TTL: %w", err)
+	}
+
+	tlsReloadInterval, err := time.ParseDuration(viper.GetString("TLS_RELOAD_INTERVAL"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS_RELOAD_INTERVAL: %w", err)
+	}
+
 	config := &Config{
 		ServiceName:              viper.GetString("SERVICE_NAME"),
 		HTTPPort:                 viper.GetInt("HTTP_PORT"),
 		AllowOrigins:             origins,
-		JWTSecret:                viper.GetString("JWT_SECRET"),
+		JWTSigningKeyFile:        viper.GetString("JWT_SIGNING_KEY_FILE"),
+		JWTSigningKeyID:          viper.GetString("JWT_SIGNING_KEY_ID"),
+		JWTVerificationKeysDir:   viper.GetString("JWT_VERIFICATION_KEYS_DIR"),
+		AccessTokenTTL:           accessTokenTTL,
+		RefreshTokenTTL:          refreshTokenTTL,
 		RateLimitRPS:             viper.GetInt("RATE_LIMIT_RPS"),
 		RateLimitBurst:           viper.GetInt("RATE_LIMIT_BURST"),
 		RedisURL:                 viper.GetString("REDIS_URL"),
+		TLSCAFile:                viper.GetString("TLS_CA_FILE"),
+		TLSCertFile:              viper.GetString("TLS_CERT_FILE"),
+		TLSKeyFile:               viper.GetString("TLS_KEY_FILE"),
+		TLSReloadInterval:        tlsReloadInterval,
 		IdentityGRPCAddr:         viper.GetString("IDENTITY_GRPC_ADDR"),
 		StorageGRPCAddr:          viper.GetString("STORAGE_GRPC_ADDR"),
 		AdminGRPCAddr:            viper.GetString("ADMIN_GRPC_ADDR"),
+		LivenessGRPCAddr:         viper.GetString("LIVENESS_GRPC_ADDR"),
+		ScoringGRPCAddr:          viper.GetString("SCORING_GRPC_ADDR"),
 		OTELExporterOTLPEndpoint: viper.GetString("OTEL_EXPORTER_OTLP_ENDPOINT"),
 		PrometheusMetricsPath:    viper.GetString("PROMETHEUS_METRICS_PATH"),
 		MaxRequestBodySize:       viper.GetInt64("MAX_REQUEST_BODY_SIZE"),
@@ -115,8 +165,17 @@ func (c *Config) validate() error {
 	if c.HTTPPort <= 0 || c.HTTPPort > 65535 {
 		return fmt.Errorf("HTTP_PORT must be between 1 and 65535")
 	}
-	if c.JWTSecret == "" || c.JWTSecret == "changeme" {
-		return fmt.Errorf("JWT_SECRET must be set to a secure value")
+	if c.JWTSigningKeyFile == "" && !c.IsDevelopment() {
+		return fmt.Errorf("JWT_SIGNING_KEY_FILE is required outside development")
+	}
+	if c.JWTSigningKeyFile != "" && c.JWTSigningKeyID == "" {
+		return fmt.Errorf("JWT_SIGNING_KEY_ID is required when JWT_SIGNING_KEY_FILE is set")
+	}
+	if c.AccessTokenTTL <= 0 {
+		return fmt.Errorf("ACCESS_TOKEN_TTL must be positive")
+	}
+	if c.RefreshTokenTTL <= c.AccessTokenTTL {
+		return fmt.Errorf("REFRESH_TOKEN_TTL must be longer than ACCESS_TOKEN_TTL")
 	}
 	if c.RedisURL == "" {
 		return fmt.Errorf("REDIS_URL is required")
@@ -130,9 +189,46 @@ func (c *Config) validate() error {
 	if c.AdminGRPCAddr == "" {
 		return fmt.Errorf("ADMIN_GRPC_ADDR is required")
 	}
+	if c.LivenessGRPCAddr == "" {
+		return fmt.Errorf("LIVENESS_GRPC_ADDR is required")
+	}
+	if c.ScoringGRPCAddr == "" {
+		return fmt.Errorf("SCORING_GRPC_ADDR is required")
+	}
 	return nil
 }
 
+// TLSOptions returns the mTLS options for gRPC clients. mTLS is required
+// outside development.
+func (c *Config) TLSOptions() mtls.Options {
+	return mtls.Options{
+		CAFile:         c.TLSCAFile,
+		CertFile:       c.TLSCertFile,
+		KeyFile:        c.TLSKeyFile,
+		ReloadInterval: c.TLSReloadInterval,
+		Required:       !c.IsDevelopment(),
+	}
+}
+
+// TelemetryConfig returns the shared service configuration read by pkg/otel.
+// The collector is dialled over gRPC, so the endpoint's scheme is dropped.
+func (c *Config) TelemetryConfig() *pkgconfig.Config {
+	endpoint := strings.TrimPrefix(c.OTELExporterOTLPEndpoint, "http://")
+	endpoint = strings.TrimPrefix(endpoint, "https://")
+
+	environment := "production"
+	if c.IsDevelopment() {
+		environment = "development"
+	}
+
+	return &pkgconfig.Config{
+		ServiceName:           c.ServiceName,
+		Environment:           environment,
+		OTELCollectorEndpoint: endpoint,
+		OTELServiceName:       c.ServiceName,
+	}
+}
+
 // GetHTTPAddr returns the HTTP address string
 func (c *Config) GetHTTPAddr() string {
 	return fmt.Sprintf(":%d", c.HTTPPort)
@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/api-gateway/internal/clients"
+	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
+	"github.com/ekyc-backend/services/api-gateway/internal/server"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// ListSessionsQuery filters and pages the session list
+type ListSessionsQuery struct {
+	Status string `validate:"omitempty,oneof=CREATED DOC_UPLOADED SELFIE_UPLOADED LIVENESS_PENDING UNDER_REVIEW APPROVED REJECTED"`
+	Query  string `validate:"omitempty,max=256"`
+	Page   string `validate:"omitempty,number"`
+	Size   string `validate:"omitempty,number"`
+}
+
+// SessionSummaryResponse is one entry of the session list
+type SessionSummaryResponse struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"userId"`
+	Status    string    `json:"status"`
+	Score     int32     `json:"score"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SessionListResponse is a page of sessions
+type SessionListResponse struct {
+	Items []SessionSummaryResponse `json:"items"`
+	Total int32                    `json:"total"`
+	Page  int32                    `json:"page"`
+	Size  int32                    `json:"size"`
+}
+
+// ArtifactResponse describes an uploaded artifact
+type ArtifactResponse struct {
+	Type       string    `json:"type,omitempty"`
+	Key        string    `json:"key"`
+	UploadedAt time.Time `json:"uploadedAt"`
+}
+
+// SessionDetailResponse describes a session and its uploaded artifacts
+type SessionDetailResponse struct {
+	ID           string             `json:"id"`
+	UserID       string             `json:"userId"`
+	Status       string             `json:"status"`
+	Score        int32              `json:"score"`
+	PendingSteps []string           `json:"pendingSteps"`
+	Documents    []ArtifactResponse `json:"documents"`
+	Selfie       *ArtifactResponse  `json:"selfie,omitempty"`
+	Liveness     *ArtifactResponse  `json:"liveness,omitempty"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	UpdatedAt    time.Time          `json:"updatedAt"`
+}
+
+// AdminDecisionRequest approves or rejects a session under review
+type AdminDecisionRequest struct {
+	Status string `json:"status" validate:"required,oneof=APPROVED REJECTED"`
+	Note   string `json:"note" validate:"max=1000"`
+}
+
+// AdminDecisionResponse acknowledges an applied decision
+type AdminDecisionResponse struct {
+	SessionID string    `json:"sessionId"`
+	Status    string    `json:"status"`
+	Note      string    `json:"note"`
+	DecidedBy string    `json:"decidedBy"`
+	DecidedAt time.Time `json:"decidedAt"`
+}
+
+// AdminHandler handles admin session review endpoints
+type AdminHandler struct {
+	logger      *logger.Logger
+	validator   *server.Validator
+	adminClient *clients.AdminClient
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(logger *logger.Logger, validator *server.Validator, adminClient *clients.AdminClient) *AdminHandler {
+	return &AdminHandler{
+		logger:      logger,
+		validator:   validator,
+		adminClient: adminClient,
+	}
+}
+
+// ListSessions handles GET /api/v1/admin/sessions
+func (h *AdminHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	query := ListSessionsQuery{
+		Status: r.URL.Query().Get("status"),
+		Query:  r.URL.Query().Get("q"),
+		Page:   r.URL.Query().Get("page"),
+		Size:   r.URL.Query().Get("size"),
+	}
+	if !h.validator.ValidateQuery(w, r, &query) {
+		return
+	}
+
+	var page, size int64
+	if query.Page != "" {
+		var err error
+		if page, err = strconv.ParseInt(query.Page, 10, 32); err != nil || page < 1 {
+			server.BadRequestResponse(w, "Invalid query parameters", "page must be a positive integer", correlationID)
+			return
+		}
+	}
+	if query.Size != "" {
+		var err error
+		if size, err = strconv.ParseInt(query.Size, 10, 32); err != nil || size < 1 || size > 100 {
+			server.BadRequestResponse(w, "Invalid query parameters", "size must be between 1 and 100", correlationID)
+			return
+		}
+	}
+
+	filter := &proto.SessionFilter{
+		Status: proto.SessionStatus(proto.SessionStatus_value[query.Status]),
+		Query:  query.Query,
+	}
+
+	resp, err := h.adminClient.ListSessions(ctx, filter, int32(page), int32(size))
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	list := SessionListResponse{
+		Items: []SessionSummaryResponse{},
+		Total: resp.GetTotal(),
+		Page:  resp.GetPage(),
+		Size:  resp.GetSize(),
+	}
+	for _, s := range resp.GetSessions() {
+		list.Items = append(list.Items, SessionSummaryResponse{
+			ID:        s.GetId(),
+			UserID:    s.GetUserId(),
+			Status:    s.GetStatus().String(),
+			Score:     s.GetScore(),
+			CreatedAt: s.GetCreatedAt().AsTime(),
+			UpdatedAt: s.GetUpdatedAt().AsTime(),
+		})
+	}
+
+	server.SuccessResponse(w, list, http.StatusOK)
+}
+
+// GetSessionDetail handles GET /api/v1/admin/sessions/{id}
+func (h *AdminHandler) GetSessionDetail(w http.ResponseWriter, r *http.Request) {
+	path := sessionPath{ID: chi.URLParam(r, "id")}
+	if !h.validator.ValidatePath(w, r, &path) {
+		return
+	}
+
+	resp, err := h.adminClient.GetSessionDetail(r.Context(), path.ID)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	s := resp.GetSession()
+	detail := SessionDetailResponse{
+		ID:           s.GetId(),
+		UserID:       s.GetUserId(),
+		Status:       s.GetStatus().String(),
+		Score:        s.GetScore(),
+		PendingSteps: s.GetPendingSteps(),
+		Documents:    []ArtifactResponse{},
+		CreatedAt:    s.GetCreatedAt().AsTime(),
+		UpdatedAt:    s.GetUpdatedAt().AsTime(),
+	}
+	if detail.PendingSteps == nil {
+		detail.PendingSteps = []string{}
+	}
+	for _, document := range s.GetDocuments() {
+		detail.Documents = append(detail.Documents, ArtifactResponse{
+			Type:       document.GetType().String(),
+			Key:        document.GetKey(),
+			UploadedAt: document.GetUploadedAt().AsTime(),
+		})
+	}
+	if selfie := s.GetSelfie(); selfie != nil {
+		detail.Selfie = &ArtifactResponse{Key: selfie.GetKey(), UploadedAt: selfie.GetUploadedAt().AsTime()}
+	}
+	if liveness := s.GetLiveness(); liveness != nil {
+		detail.Liveness = &ArtifactResponse{Key: liveness.GetKey(), UploadedAt: liveness.GetUploadedAt().AsTime()}
+	}
+
+	server.SuccessResponse(w, detail, http.StatusOK)
+}
+
+// ApplyDecision handles POST /api/v1/admin/sessions/{id}/decision
+func (h *AdminHandler) ApplyDecision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	path := sessionPath{ID: chi.URLParam(r, "id")}
+	if !h.validator.ValidatePath(w, r, &path) {
+		return
+	}
+
+	var req AdminDecisionRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+
+	decision := proto.DecisionStatus(proto.DecisionStatus_value["DECISION_"+req.Status])
+	resp, err := h.adminClient.ApplyDecision(ctx, path.ID, decision, req.Note, middleware.GetUserID(ctx))
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	server.SuccessResponse(w, AdminDecisionResponse{
+		SessionID: resp.GetSessionId(),
+		Status:    strings.TrimPrefix(resp.GetDecision().String(), "DECISION_"),
+		Note:      resp.GetNote(),
+		DecidedBy: resp.GetDecidedBy(),
+		DecidedAt: resp.GetDecidedAt().AsTime(),
+	}, http.StatusOK)
+}
+
+// respondError maps admin service errors to HTTP responses
+func (h *AdminHandler) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	st := grpcStatus(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		server.BadRequestResponse(w, "Invalid request", st.Message(), correlationID)
+	case codes.NotFound:
+		server.NotFoundResponse(w, "Session not found", correlationID)
+	case codes.FailedPrecondition:
+		server.ConflictResponse(w, "Session is not in a valid state for this operation", st.Message(), correlationID)
+	case codes.PermissionDenied:
+		server.ForbiddenResponse(w, "Access denied", correlationID)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		server.LogAndRespond(h.logger, w, err, http.StatusServiceUnavailable, correlationID)
+	default:
+		h.logger.WithContext(ctx).Error("Admin request failed",
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+	}
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/api-gateway/internal/clients"
+	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
+	"github.com/ekyc-backend/services/api-gateway/internal/security"
+	"github.com/ekyc-backend/services/api-gateway/internal/server"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// SignUpRequest registers a new user
+type SignUpRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8,max=128"`
+}
+
+// SignUpResponse is returned after a successful registration
+type SignUpResponse struct {
+	UserID  string `json:"userId"`
+	Message string `json:"message"`
+}
+
+// SignInRequest authenticates a user
+type SignInRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+// RefreshRequest exchanges a refresh token for a new token pair
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// LogoutRequest revokes a refresh token and every token rotated from it
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// TokenResponse carries an issued access and refresh token pair
+type TokenResponse struct {
+	AccessToken           string `json:"accessToken"`
+	TokenType             string `json:"tokenType"`
+	ExpiresIn             int64  `json:"expiresIn"`
+	RefreshToken          string `json:"refreshToken"`
+	RefreshTokenExpiresIn int64  `json:"refreshTokenExpiresIn"`
+}
+
+// LogoutResponse acknowledges a logout
+type LogoutResponse struct {
+	Message string `json:"message"`
+}
+
+// AuthHandler handles authentication endpoints
+type AuthHandler struct {
+	logger         *logger.Logger
+	validator      *server.Validator
+	identityClient *clients.IdentityClient
+	jwtManager     *security.JWTManager
+	refreshTokens  *security.RefreshTokenStore
+	denylist       *security.Denylist
+}
+
+// NewAuthHandler creates a new auth handler
+func NewAuthHandler(
+	logger *logger.Logger,
+	validator *server.Validator,
+	identityClient *clients.IdentityClient,
+	jwtManager *security.JWTManager,
+	refreshTokens *security.RefreshTokenStore,
+	denylist *security.Denylist,
+) *AuthHandler {
+	return &AuthHandler{
+		logger:         logger,
+		validator:      validator,
+		identityClient: identityClient,
+		jwtManager:     jwtManager,
+		refreshTokens:  refreshTokens,
+		denylist:       denylist,
+	}
+}
+
+// SignUp handles POST /api/v1/auth/signup
+func (h *AuthHandler) SignUp(w http.ResponseWriter, r *http.Request) {
+	var req SignUpRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+
+	resp, err := h.identityClient.SignUp(r.Context(), req.Email, req.Password)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	server.SuccessResponse(w, SignUpResponse{
+		UserID:  resp.GetUserId(),
+		Message: resp.GetMessage(),
+	}, http.StatusCreated)
+}
+
+// SignIn handles POST /api/v1/auth/signin
+func (h *AuthHandler) SignIn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req SignInRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+
+	resp, err := h.identityClient.SignIn(ctx, req.Email, req.Password)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	refreshToken, err := h.refreshTokens.Issue(ctx, resp.GetUserId())
+	if err != nil {
+		h.logger.WithContext(ctx).Error("Failed to issue refresh token",
+			zap.String("user_id", resp.GetUserId()),
+			zap.Error(err),
+		)
+		server.InternalServerErrorResponse(w, "Internal server error", middleware.GetCorrelationID(ctx))
+		return
+	}
+
+	h.respondTokens(w, r, resp.GetUserId(), resp.GetRoles(), refreshToken)
+}
+
+// Refresh handles POST /api/v1/auth/refresh
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	var req RefreshRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+
+	record, refreshToken, err := h.refreshTokens.Rotate(ctx, req.RefreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, security.ErrRefreshTokenReused):
+			h.logger.WithContext(ctx).Warn("Refresh token reuse detected, token family revoked",
+				zap.String("user_id", record.UserID),
+				zap.String("family_id", record.FamilyID),
+			)
+			server.UnauthorizedResponse(w, "Invalid refresh token", correlationID)
+		case errors.Is(err, security.ErrInvalidRefreshToken):
+			server.UnauthorizedResponse(w, "Invalid refresh token", correlationID)
+		default:
+			h.logger.WithContext(ctx).Error("Failed to rotate refresh token", zap.Error(err))
+			server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+		}
+		return
+	}
+
+	// A user revocation also covers refresh tokens issued before the cutoff
+	revoked, err := h.denylist.IsUserRevoked(ctx, record.UserID, record.IssuedAt)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("Failed to check token revocation", zap.Error(err))
+		server.ServiceUnavailableResponse(w, "Unable to verify token", correlationID)
+		return
+	}
+	if revoked {
+		h.revokeFamily(w, r, record.FamilyID)
+		return
+	}
+
+	// Roles and status are read again so a demotion or a disable applies at
+	// the next refresh rather than when the token family expires
+	user, err := h.identityClient.GetUser(ctx, record.UserID)
+	if err != nil {
+		if grpcStatus(err).Code() == codes.NotFound {
+			h.revokeFamily(w, r, record.FamilyID)
+			return
+		}
+		h.logger.WithContext(ctx).Error("Failed to load user for refresh",
+			zap.String("user_id", record.UserID),
+			zap.Error(err),
+		)
+		server.ServiceUnavailableResponse(w, "Unable to verify token", correlationID)
+		return
+	}
+	if user.GetDisabled() {
+		h.logger.WithContext(ctx).Warn("Refresh by disabled user, token family revoked",
+			zap.String("user_id", record.UserID),
+			zap.String("family_id", record.FamilyID),
+		)
+		h.revokeFamily(w, r, record.FamilyID)
+		return
+	}
+
+	h.respondTokens(w, r, record.UserID, user.GetRoles(), refreshToken)
+}
+
+// revokeFamily revokes a token family that may no longer be refreshed and
+// rejects the refresh
+func (h *AuthHandler) revokeFamily(w http.ResponseWriter, r *http.Request, familyID string) {
+	ctx := r.Context()
+
+	if err := h.refreshTokens.RevokeFamily(ctx, familyID); err != nil {
+		h.logger.WithContext(ctx).Error("Failed to revoke token family", zap.Error(err))
+	}
+	server.UnauthorizedResponse(w, "Invalid refresh token", middleware.GetCorrelationID(ctx))
+}
+
+// Logout handles POST /api/v1/auth/logout
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req LogoutRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+
+	// Logging out with an unknown or already revoked token is not an error
+	if err := h.refreshTokens.Revoke(ctx, req.RefreshToken); err != nil && !errors.Is(err, security.ErrInvalidRefreshToken) {
+		h.logger.WithContext(ctx).Error("Failed to revoke refresh token", zap.Error(err))
+		server.InternalServerErrorResponse(w, "Internal server error", middleware.GetCorrelationID(ctx))
+		return
+	}
+
+	server.SuccessResponse(w, LogoutResponse{Message: "Logged out successfully"}, http.StatusOK)
+}
+
+// respondTokens mints an access token and writes it with the refresh token
+func (h *AuthHandler) respondTokens(w http.ResponseWriter, r *http.Request, userID string, roles []string, refreshToken string) {
+	ctx := r.Context()
+
+	expiration := h.jwtManager.TokenExpiration()
+	token, err := h.jwtManager.GenerateToken(userID, roles, expiration)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("Failed to generate token",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		server.InternalServerErrorResponse(w, "Internal server error", middleware.GetCorrelationID(ctx))
+		return
+	}
+
+	server.SuccessResponse(w, TokenResponse{
+		AccessToken:           token,
+		TokenType:             "Bearer",
+		ExpiresIn:             int64(expiration.Seconds()),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresIn: int64(h.refreshTokens.TTL().Seconds()),
+	}, http.StatusOK)
+}
+
+// respondError maps identity service errors to HTTP responses
+func (h *AuthHandler) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	st := grpcStatus(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		server.BadRequestResponse(w, "Invalid request", st.Message(), correlationID)
+	case codes.AlreadyExists:
+		server.ConflictResponse(w, "Email is already registered", st.Message(), correlationID)
+	case codes.Unauthenticated:
+		server.UnauthorizedResponse(w, "Invalid email or password", correlationID)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		server.LogAndRespond(h.logger, w, err, http.StatusServiceUnavailable, correlationID)
+	default:
+		h.logger.WithContext(ctx).Error("Auth request failed",
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+	}
+}
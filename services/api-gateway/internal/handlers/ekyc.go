@@ -0,0 +1,412 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/objectkey"
+	"github.com/ekyc-backend/services/api-gateway/internal/clients"
+	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
+	"github.com/ekyc-backend/services/api-gateway/internal/server"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	// PresignAction is the query action that requests a presigned upload URL
+	PresignAction = "PRESIGN"
+	// PresignExpiration is how long presigned upload URLs stay valid
+	PresignExpiration = 15 * time.Minute
+
+	// SelfieArtifact is the artifact type of selfie uploads
+	SelfieArtifact = "SELFIE"
+	// LivenessArtifact is the artifact type of liveness clip uploads
+	LivenessArtifact = "LIVENESS_CLIP"
+)
+
+// CreateSessionResponse is returned when a new eKYC session is created
+type CreateSessionResponse struct {
+	SessionID string    `json:"sessionId"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SessionStatusResponse describes the current state of an eKYC session
+type SessionStatusResponse struct {
+	SessionID    string    `json:"sessionId"`
+	Status       string    `json:"status"`
+	Score        int32     `json:"score"`
+	PendingSteps []string  `json:"pendingSteps"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// DocumentPresignRequest requests a presigned URL for a document upload.
+// DocumentType selects the card type of DOC_FRONT and DOC_BACK uploads and
+// may be omitted for PASSPORT. ChecksumSHA256 is the base64 SHA-256 of the
+// file in every presign request; an upload that does not match it is rejected.
+type DocumentPresignRequest struct {
+	Type           string `json:"type" validate:"required,oneof=DOC_FRONT DOC_BACK PASSPORT"`
+	DocumentType   string `json:"documentType" validate:"omitempty,oneof=CCCD CMND PASSPORT"`
+	ContentType    string `json:"contentType" validate:"required,oneof=image/jpeg image/png"`
+	ChecksumSHA256 string `json:"checksumSha256" validate:"required,base64,len=44"`
+}
+
+// DocumentUploadRequest asks for an uploaded document to be verified without
+// waiting for the bucket notification
+type DocumentUploadRequest struct {
+	Key  string `json:"key" validate:"required"`
+	Type string `json:"type" validate:"required,oneof=DOC_FRONT DOC_BACK PASSPORT"`
+}
+
+// SelfiePresignRequest requests a presigned URL for a selfie upload
+type SelfiePresignRequest struct {
+	ContentType    string `json:"contentType" validate:"required,oneof=image/jpeg image/png"`
+	ChecksumSHA256 string `json:"checksumSha256" validate:"required,base64,len=44"`
+}
+
+// SelfieUploadRequest confirms a selfie upload
+type SelfieUploadRequest struct {
+	Key string `json:"key" validate:"required"`
+}
+
+// LivenessPresignRequest requests a presigned URL for a liveness clip upload
+type LivenessPresignRequest struct {
+	ContentType    string `json:"contentType" validate:"required,oneof=video/mp4 video/webm"`
+	ChecksumSHA256 string `json:"checksumSha256" validate:"required,base64,len=44"`
+}
+
+// LivenessUploadRequest confirms a liveness clip upload
+type LivenessUploadRequest struct {
+	Key string `json:"key" validate:"required"`
+}
+
+// LivenessChallengeResponse is the task the user performs in the liveness
+// clip. Actions are head movements or blinks in order; Digits are read aloud.
+// The clip must be uploaded before ExpiresAt.
+type LivenessChallengeResponse struct {
+	ChallengeID string    `json:"challengeId"`
+	Kind        string    `json:"kind"`
+	Actions     []string  `json:"actions"`
+	Digits      string    `json:"digits,omitempty"`
+	IssuedAt    time.Time `json:"issuedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// PresignedURLResponse carries a single-use upload to the storage service. The
+// client posts Fields as multipart form fields, followed by the file in a part
+// named "file".
+type PresignedURLResponse struct {
+	URL       string            `json:"url"`
+	Fields    map[string]string `json:"fields"`
+	Key       string            `json:"key"`
+	MaxSize   int64             `json:"maxSize"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// UploadResponse acknowledges an upload confirmation
+type UploadResponse struct {
+	Accepted bool   `json:"accepted"`
+	Message  string `json:"message"`
+}
+
+// sessionPath holds the validated session path parameter
+type sessionPath struct {
+	ID string `validate:"required,uuid"`
+}
+
+// EKYCHandler handles eKYC session endpoints
+type EKYCHandler struct {
+	logger         *logger.Logger
+	validator      *server.Validator
+	identityClient *clients.IdentityClient
+	storageClient  *clients.StorageClient
+	livenessClient *clients.LivenessClient
+}
+
+// NewEKYCHandler creates a new eKYC handler
+func NewEKYCHandler(
+	logger *logger.Logger,
+	validator *server.Validator,
+	identityClient *clients.IdentityClient,
+	storageClient *clients.StorageClient,
+	livenessClient *clients.LivenessClient,
+) *EKYCHandler {
+	return &EKYCHandler{
+		logger:         logger,
+		validator:      validator,
+		identityClient: identityClient,
+		storageClient:  storageClient,
+		livenessClient: livenessClient,
+	}
+}
+
+// CreateSession handles POST /api/v1/ekyc/session
+func (h *EKYCHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := middleware.GetUserID(ctx)
+
+	resp, err := h.identityClient.CreateSession(ctx, userID)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	server.SuccessResponse(w, CreateSessionResponse{
+		SessionID: resp.GetSessionId(),
+		Status:    resp.GetStatus().String(),
+		CreatedAt: resp.GetCreatedAt().AsTime(),
+	}, http.StatusCreated)
+}
+
+// GetSessionStatus handles GET /api/v1/ekyc/{id}/status
+func (h *EKYCHandler) GetSessionStatus(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.authorizeSession(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.identityClient.GetSessionStatus(r.Context(), sessionID)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	server.SuccessResponse(w, SessionStatusResponse{
+		SessionID:    resp.GetSessionId(),
+		Status:       resp.GetStatus().String(),
+		Score:        resp.GetScore(),
+		PendingSteps: resp.GetPendingSteps(),
+		UpdatedAt:    resp.GetUpdatedAt().AsTime(),
+	}, http.StatusOK)
+}
+
+// UploadDocument handles POST /api/v1/ekyc/{id}/document
+func (h *EKYCHandler) UploadDocument(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.authorizeSession(w, r)
+	if !ok {
+		return
+	}
+
+	if r.URL.Query().Get("action") == PresignAction {
+		var req DocumentPresignRequest
+		if !h.validator.ValidateRequest(w, r, &req) {
+			return
+		}
+		h.presign(w, r, sessionID, req.Type, req.DocumentType, req.ContentType, req.ChecksumSHA256)
+		return
+	}
+
+	var req DocumentUploadRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+	if !h.validateKey(w, r, sessionID, req.Type, req.Key) || !h.confirmUpload(w, r, sessionID, req.Key) {
+		return
+	}
+
+	server.SuccessResponse(w, UploadResponse{Accepted: true, Message: "Document received"}, http.StatusOK)
+}
+
+// UploadSelfie handles POST /api/v1/ekyc/{id}/selfie
+func (h *EKYCHandler) UploadSelfie(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.authorizeSession(w, r)
+	if !ok {
+		return
+	}
+
+	if r.URL.Query().Get("action") == PresignAction {
+		var req SelfiePresignRequest
+		if !h.validator.ValidateRequest(w, r, &req) {
+			return
+		}
+		h.presign(w, r, sessionID, SelfieArtifact, "", req.ContentType, req.ChecksumSHA256)
+		return
+	}
+
+	var req SelfieUploadRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+	if !h.validateKey(w, r, sessionID, SelfieArtifact, req.Key) || !h.confirmUpload(w, r, sessionID, req.Key) {
+		return
+	}
+
+	server.SuccessResponse(w, UploadResponse{Accepted: true, Message: "Selfie received"}, http.StatusOK)
+}
+
+// UploadLiveness handles POST /api/v1/ekyc/{id}/liveness
+func (h *EKYCHandler) UploadLiveness(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.authorizeSession(w, r)
+	if !ok {
+		return
+	}
+
+	if r.URL.Query().Get("action") == PresignAction {
+		var req LivenessPresignRequest
+		if !h.validator.ValidateRequest(w, r, &req) {
+			return
+		}
+		h.presign(w, r, sessionID, LivenessArtifact, "", req.ContentType, req.ChecksumSHA256)
+		return
+	}
+
+	var req LivenessUploadRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+	if !h.validateKey(w, r, sessionID, LivenessArtifact, req.Key) || !h.confirmUpload(w, r, sessionID, req.Key) {
+		return
+	}
+
+	server.SuccessResponse(w, UploadResponse{Accepted: true, Message: "Liveness clip received"}, http.StatusOK)
+}
+
+// GetLivenessChallenge handles GET /api/v1/ekyc/{id}/liveness/challenge.
+// The liveness clip must perform this challenge; calling again returns the
+// same challenge until it expires or a clip answers it.
+func (h *EKYCHandler) GetLivenessChallenge(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.authorizeSession(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.livenessClient.GetChallenge(r.Context(), sessionID)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	server.SuccessResponse(w, LivenessChallengeResponse{
+		ChallengeID: resp.GetChallengeId(),
+		Kind:        strings.TrimPrefix(resp.GetKind().String(), "LIVENESS_CHALLENGE_"),
+		Actions:     resp.GetActions(),
+		Digits:      resp.GetDigits(),
+		IssuedAt:    resp.GetIssuedAt().AsTime(),
+		ExpiresAt:   resp.GetExpiresAt().AsTime(),
+	}, http.StatusOK)
+}
+
+// presign issues a presigned POST policy for a new artifact of the session.
+// The storage service picks the object key and enforces type and size limits
+// and the declared checksum. documentType is the short document type name,
+// or "" for non-documents.
+func (h *EKYCHandler) presign(w http.ResponseWriter, r *http.Request, sessionID, artifactType, documentType, contentType, checksum string) {
+	resp, err := h.storageClient.GetPresignedPostPolicy(r.Context(), sessionID,
+		proto.ArtifactType(proto.ArtifactType_value[artifactType]),
+		proto.DocumentType(proto.DocumentType_value["DOCUMENT_TYPE_"+documentType]),
+		contentType, checksum, PresignExpiration)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	server.SuccessResponse(w, PresignedURLResponse{
+		URL:       resp.GetUrl(),
+		Fields:    resp.GetFormData(),
+		Key:       resp.GetObjectKey(),
+		MaxSize:   resp.GetMaxSizeBytes(),
+		ExpiresAt: time.Now().UTC().Add(time.Duration(resp.GetExpiresIn()) * time.Second),
+	}, http.StatusOK)
+}
+
+// authorizeSession validates the session path parameter and checks that the
+// session belongs to the caller. Admins may access any session.
+func (h *EKYCHandler) authorizeSession(w http.ResponseWriter, r *http.Request) (string, bool) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	path := sessionPath{ID: chi.URLParam(r, "id")}
+	if !h.validator.ValidatePath(w, r, &path) {
+		return "", false
+	}
+
+	resp, err := h.identityClient.GetSessionStatus(ctx, path.ID)
+	if err != nil {
+		h.respondError(w, r, err)
+		return "", false
+	}
+
+	userID := middleware.GetUserID(ctx)
+	if resp.GetUserId() != userID && !hasRole(middleware.GetUserRoles(ctx), "ADMIN") {
+		h.logger.WithContext(ctx).WithSessionID(path.ID).Warn("Session ownership check failed",
+			zap.String("user_id", userID),
+		)
+		server.ForbiddenResponse(w, "Session does not belong to the current user", correlationID)
+		return "", false
+	}
+
+	return path.ID, true
+}
+
+// validateKey ensures a confirmed object key was issued for this session and artifact type
+func (h *EKYCHandler) validateKey(w http.ResponseWriter, r *http.Request, sessionID, artifactType, key string) bool {
+	parsed, err := objectkey.Parse(key)
+	if err != nil || parsed.SessionID != sessionID || parsed.ArtifactType != artifactType {
+		server.BadRequestResponse(w, "Invalid object key", "Key must be issued for this session and artifact type", middleware.GetCorrelationID(r.Context()))
+		return false
+	}
+	return true
+}
+
+// confirmUpload has the storage service verify the uploaded object and record it.
+// The session advances once the storage service publishes artifact.uploaded,
+// whether from this call or from the bucket notification.
+func (h *EKYCHandler) confirmUpload(w http.ResponseWriter, r *http.Request, sessionID, key string) bool {
+	if _, err := h.storageClient.ConfirmUpload(r.Context(), sessionID, key); err != nil {
+		h.respondError(w, r, err)
+		return false
+	}
+	return true
+}
+
+// respondError maps downstream gRPC errors to HTTP responses
+func (h *EKYCHandler) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	st := grpcStatus(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		server.BadRequestResponse(w, "Invalid request", st.Message(), correlationID)
+	case codes.NotFound:
+		server.NotFoundResponse(w, "Session not found", correlationID)
+	case codes.FailedPrecondition:
+		server.ConflictResponse(w, "Session is not in a valid state for this operation", st.Message(), correlationID)
+	case codes.PermissionDenied:
+		server.ForbiddenResponse(w, "Access denied", correlationID)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		server.LogAndRespond(h.logger, w, err, http.StatusServiceUnavailable, correlationID)
+	default:
+		h.logger.WithContext(ctx).Error("eKYC request failed",
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+	}
+}
+
+// grpcStatus extracts the gRPC status wrapped by a client error
+func grpcStatus(err error) *status.Status {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus()
+	}
+	return status.Convert(err)
+}
+
+// hasRole reports whether roles contains the given role
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
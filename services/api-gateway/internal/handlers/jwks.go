@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ekyc-backend/services/api-gateway/internal/security"
+)
+
+// JWKSCacheControl lets verifiers cache the key set while still picking up
+// a rotated key well within an access token lifetime
+const JWKSCacheControl = "public, max-age=300"
+
+// JWKSHandler publishes the public keys used to verify access tokens
+type JWKSHandler struct {
+	jwtManager *security.JWTManager
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(jwtManager *security.JWTManager) *JWKSHandler {
+	return &JWKSHandler{jwtManager: jwtManager}
+}
+
+// JWKS handles GET /.well-known/jwks.json
+func (h *JWKSHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	// The key set is served bare rather than in the API envelope so that
+	// standard JOSE libraries can consume it directly
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", JWKSCacheControl)
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(h.jwtManager.JWKS())
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
+	"github.com/ekyc-backend/services/api-gateway/internal/security"
+	"github.com/ekyc-backend/services/api-gateway/internal/server"
+	"go.uber.org/zap"
+)
+
+// maxCutoffSkew is how far in the future a user revocation cutoff may lie,
+// allowing for the clock of the admin client
+const maxCutoffSkew = time.Minute
+
+// RevokeTokensRequest revokes a single token by jti or every token of a user.
+// Before defaults to the time of the request when revoking a user and may not
+// lie in the future.
+type RevokeTokensRequest struct {
+	JTI    string     `json:"jti" validate:"required_without=UserID,excluded_with=UserID"`
+	UserID string     `json:"userId" validate:"omitempty,uuid"`
+	Before *time.Time `json:"before" validate:"excluded_with=JTI"`
+}
+
+// RevokeTokensResponse acknowledges a revocation
+type RevokeTokensResponse struct {
+	JTI    string     `json:"jti,omitempty"`
+	UserID string     `json:"userId,omitempty"`
+	Before *time.Time `json:"before,omitempty"`
+}
+
+// RevocationHandler handles admin token revocation
+type RevocationHandler struct {
+	logger    *logger.Logger
+	validator *server.Validator
+	denylist  *security.Denylist
+}
+
+// NewRevocationHandler creates a new revocation handler
+func NewRevocationHandler(logger *logger.Logger, validator *server.Validator, denylist *security.Denylist) *RevocationHandler {
+	return &RevocationHandler{
+		logger:    logger,
+		validator: validator,
+		denylist:  denylist,
+	}
+}
+
+// RevokeTokens handles POST /api/v1/admin/tokens/revoke
+func (h *RevocationHandler) RevokeTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+	adminID := middleware.GetUserID(ctx)
+
+	var req RevokeTokensRequest
+	if !h.validator.ValidateRequest(w, r, &req) {
+		return
+	}
+
+	if req.JTI != "" {
+		if err := h.denylist.RevokeToken(ctx, req.JTI); err != nil {
+			h.logger.WithContext(ctx).Error("Failed to revoke token", zap.Error(err))
+			server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+			return
+		}
+
+		h.logger.WithContext(ctx).Info("Token revoked",
+			zap.String("jti", req.JTI),
+			zap.String("revoked_by", adminID),
+		)
+
+		server.SuccessResponse(w, RevokeTokensResponse{JTI: req.JTI}, http.StatusOK)
+		return
+	}
+
+	// A future cutoff would also reject every token issued until then,
+	// including those of signins after the revocation
+	now := time.Now().UTC()
+	before := now
+	if req.Before != nil {
+		before = req.Before.UTC()
+		if before.After(now.Add(maxCutoffSkew)) {
+			server.BadRequestResponse(w, "Invalid request", "before must not be in the future", correlationID)
+			return
+		}
+		if before.After(now) {
+			before = now
+		}
+	}
+
+	if err := h.denylist.RevokeUser(ctx, req.UserID, before); err != nil {
+		h.logger.WithContext(ctx).Error("Failed to revoke user tokens", zap.Error(err))
+		server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+		return
+	}
+
+	h.logger.WithContext(ctx).Info("User tokens revoked",
+		zap.String("user_id", req.UserID),
+		zap.Time("before", before),
+		zap.String("revoked_by", adminID),
+	)
+
+	server.SuccessResponse(w, RevokeTokensResponse{UserID: req.UserID, Before: &before}, http.StatusOK)
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/api-gateway/internal/clients"
+	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
+	"github.com/ekyc-backend/services/api-gateway/internal/server"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// ShadowReportQuery selects the window of a shadow report. From defaults to
+// seven days before To, and To to the time of the request.
+type ShadowReportQuery struct {
+	From  string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To    string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Limit string `validate:"omitempty,number"`
+}
+
+// RulesetRatesResponse describes the outcomes of one ruleset
+type RulesetRatesResponse struct {
+	Version       string  `json:"version"`
+	Digest        string  `json:"digest"`
+	Active        bool    `json:"active"`
+	Decisions     int32   `json:"decisions"`
+	Approved      int32   `json:"approved"`
+	Review        int32   `json:"review"`
+	Rejected      int32   `json:"rejected"`
+	ApprovalRate  float64 `json:"approvalRate"`
+	ReviewRate    float64 `json:"reviewRate"`
+	RejectionRate float64 `json:"rejectionRate"`
+	AverageScore  float64 `json:"averageScore"`
+	Flipped       int32   `json:"flipped"`
+}
+
+// RulesetOutcomeResponse is the outcome one ruleset gave a session
+type RulesetOutcomeResponse struct {
+	Version  string   `json:"version"`
+	Decision string   `json:"decision"`
+	Score    int32    `json:"score"`
+	Reasons  []string `json:"reasons"`
+}
+
+// DecisionFlipResponse is a session on which the rulesets disagree
+type DecisionFlipResponse struct {
+	DecisionID string                   `json:"decisionId"`
+	SessionID  string                   `json:"sessionId"`
+	ScoredAt   time.Time                `json:"scoredAt"`
+	Active     RulesetOutcomeResponse   `json:"active"`
+	Candidates []RulesetOutcomeResponse `json:"candidates"`
+}
+
+// ShadowReportResponse compares the active and candidate scoring rulesets
+type ShadowReportResponse struct {
+	From       time.Time              `json:"from"`
+	To         time.Time              `json:"to"`
+	Decisions  int32                  `json:"decisions"`
+	Rulesets   []RulesetRatesResponse `json:"rulesets"`
+	Flips      []DecisionFlipResponse `json:"flips"`
+	TotalFlips int32                  `json:"totalFlips"`
+}
+
+// ScoringHandler handles admin scoring endpoints
+type ScoringHandler struct {
+	logger        *logger.Logger
+	validator     *server.Validator
+	scoringClient *clients.ScoringClient
+}
+
+// NewScoringHandler creates a new scoring handler
+func NewScoringHandler(logger *logger.Logger, validator *server.Validator, scoringClient *clients.ScoringClient) *ScoringHandler {
+	return &ScoringHandler{
+		logger:        logger,
+		validator:     validator,
+		scoringClient: scoringClient,
+	}
+}
+
+// GetShadowReport handles GET /api/v1/admin/scoring/shadow-report
+func (h *ScoringHandler) GetShadowReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	query := ShadowReportQuery{
+		From:  r.URL.Query().Get("from"),
+		To:    r.URL.Query().Get("to"),
+		Limit: r.URL.Query().Get("limit"),
+	}
+	if !h.validator.ValidateQuery(w, r, &query) {
+		return
+	}
+
+	req := &proto.ShadowReportRequest{}
+	if query.From != "" {
+		from, _ := time.Parse(time.RFC3339, query.From)
+		req.From = timestamppb.New(from)
+	}
+	if query.To != "" {
+		to, _ := time.Parse(time.RFC3339, query.To)
+		req.To = timestamppb.New(to)
+	}
+	if query.Limit != "" {
+		limit, err := strconv.ParseInt(query.Limit, 10, 32)
+		if err != nil {
+			server.BadRequestResponse(w, "Invalid query parameters", "limit must be an integer", correlationID)
+			return
+		}
+		req.FlipLimit = int32(limit)
+	}
+
+	resp, err := h.scoringClient.GetShadowReport(ctx, req)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	report := ShadowReportResponse{
+		From:       resp.GetFrom().AsTime(),
+		To:         resp.GetTo().AsTime(),
+		Decisions:  resp.GetDecisions(),
+		Rulesets:   []RulesetRatesResponse{},
+		Flips:      []DecisionFlipResponse{},
+		TotalFlips: resp.GetTotalFlips(),
+	}
+	for _, rates := range resp.GetRulesets() {
+		report.Rulesets = append(report.Rulesets, RulesetRatesResponse{
+			Version:       rates.GetRulesetVersion(),
+			Digest:        rates.GetRulesetDigest(),
+			Active:        rates.GetActive(),
+			Decisions:     rates.GetDecisions(),
+			Approved:      rates.GetApproved(),
+			Review:        rates.GetReview(),
+			Rejected:      rates.GetRejected(),
+			ApprovalRate:  rates.GetApprovalRate(),
+			ReviewRate:    rates.GetReviewRate(),
+			RejectionRate: rates.GetRejectionRate(),
+			AverageScore:  rates.GetAverageScore(),
+			Flipped:       rates.GetFlipped(),
+		})
+	}
+	for _, flip := range resp.GetFlips() {
+		f := DecisionFlipResponse{
+			DecisionID: flip.GetDecisionId(),
+			SessionID:  flip.GetSessionId(),
+			ScoredAt:   flip.GetScoredAt().AsTime(),
+			Active:     outcomeResponse(flip.GetActive()),
+			Candidates: []RulesetOutcomeResponse{},
+		}
+		for _, candidate := range flip.GetCandidates() {
+			f.Candidates = append(f.Candidates, outcomeResponse(candidate))
+		}
+		report.Flips = append(report.Flips, f)
+	}
+
+	server.SuccessResponse(w, report, http.StatusOK)
+}
+
+// outcomeResponse converts a ruleset outcome, naming decisions as the
+// decision endpoints do
+func outcomeResponse(o *proto.RulesetOutcome) RulesetOutcomeResponse {
+	reasons := o.GetReasons()
+	if reasons == nil {
+		reasons = []string{}
+	}
+	return RulesetOutcomeResponse{
+		Version:  o.GetRulesetVersion(),
+		Decision: strings.TrimPrefix(o.GetDecision().String(), "DECISION_"),
+		Score:    o.GetScore(),
+		Reasons:  reasons,
+	}
+}
+
+// respondError maps scoring service errors to HTTP responses
+func (h *ScoringHandler) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+	correlationID := middleware.GetCorrelationID(ctx)
+
+	st := grpcStatus(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		server.BadRequestResponse(w, "Invalid request", st.Message(), correlationID)
+	case codes.PermissionDenied:
+		server.ForbiddenResponse(w, "Access denied", correlationID)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		server.LogAndRespond(h.logger, w, err, http.StatusServiceUnavailable, correlationID)
+	default:
+		h.logger.WithContext(ctx).Error("Scoring request failed",
+			zap.String("path", r.URL.Path),
+			zap.Error(err),
+		)
+		server.InternalServerErrorResponse(w, "Internal server error", correlationID)
+	}
+}
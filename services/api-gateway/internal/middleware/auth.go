@@ -20,10 +20,12 @@ const (
 	UserIDContextKey = "user_id"
 	// UserRolesContextKey is the context key for user roles
 	UserRolesContextKey = "user_roles"
+	// AccessTokenContextKey is the context key for the verified bearer token
+	AccessTokenContextKey = "access_token"
 )
 
-// Auth middleware validates JWT tokens and extracts user information
-func Auth(jwtManager *security.JWTManager, log *logger.Logger) func(http.Handler) http.Handler {
+// Auth middleware validates JWT tokens, rejects revoked ones and extracts user information
+func Auth(jwtManager *security.JWTManager, denylist *security.Denylist, log *logger.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -72,9 +74,32 @@ func Auth(jwtManager *security.JWTManager, log *logger.Logger) func(http.Handler
 				return
 			}
 
+			// Check revocation. Fail closed: a token is not accepted if the
+			// denylist cannot be consulted.
+			revoked, err := denylist.IsRevoked(ctx, claims)
+			if err != nil {
+				log.WithContext(ctx).Error("Failed to check token revocation",
+					zap.String("path", r.URL.Path),
+					zap.Error(err),
+				)
+				writeServiceUnavailableResponse(w, "Unable to verify token")
+				return
+			}
+			if revoked {
+				log.WithContext(ctx).Warn("Revoked token",
+					zap.String("path", r.URL.Path),
+					zap.String("method", r.Method),
+					zap.String("user_id", claims.UserID),
+					zap.String("jti", claims.ID),
+				)
+				writeUnauthorizedResponse(w, "Token has been revoked")
+				return
+			}
+
 			// Add user information to context
 			ctx = context.WithValue(ctx, UserIDContextKey, claims.UserID)
 			ctx = context.WithValue(ctx, UserRolesContextKey, claims.Roles)
+			ctx = context.WithValue(ctx, AccessTokenContextKey, token)
 			r = r.WithContext(ctx)
 
 			// Proceed with request
@@ -163,6 +188,21 @@ func writeUnauthorizedResponse(w http.ResponseWriter, message string) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// writeServiceUnavailableResponse writes a 503 Service Unavailable response
+func writeServiceUnavailableResponse(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+
+	response := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    "SERVICE_UNAVAILABLE",
+			"message": message,
+		},
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
 // writeForbiddenResponse writes a 403 Forbidden response
 func writeForbiddenResponse(w http.ResponseWriter, message string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -185,3 +225,11 @@ func GetUserRoles(ctx context.Context) []string {
 	}
 	return []string{}
 }
+
+// GetAccessToken extracts the caller's verified bearer token from context
+func GetAccessToken(ctx context.Context) string {
+	if token, ok := ctx.Value(AccessTokenContextKey).(string); ok {
+		return token
+	}
+	return ""
+}
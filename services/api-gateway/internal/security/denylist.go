@@ -0,0 +1,39 @@
+package security
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/storage"
+)
+
+// Denylist records revoked access tokens in Redis. It is the denylist the
+// gRPC services check, with revocations kept for the gateway's token
+// lifetimes: single tokens for the access token lifetime, user cutoffs for
+// the refresh token lifetime.
+type Denylist struct {
+	*jwtkeys.Denylist
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewDenylist creates a new token denylist
+func NewDenylist(redis *storage.Redis, accessTTL, refreshTTL time.Duration) *Denylist {
+	return &Denylist{
+		Denylist:   jwtkeys.NewDenylist(redis),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// RevokeToken revokes a single access token by its jti
+func (d *Denylist) RevokeToken(ctx context.Context, jti string) error {
+	return d.Denylist.RevokeToken(ctx, jti, d.accessTTL)
+}
+
+// RevokeUser revokes every token issued to a user at or before the cutoff.
+// An existing later cutoff is kept.
+func (d *Denylist) RevokeUser(ctx context.Context, userID string, before time.Time) error {
+	return d.Denylist.RevokeUser(ctx, userID, before, d.refreshTTL)
+}
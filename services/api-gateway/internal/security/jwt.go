@@ -4,27 +4,34 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ekyc-backend/pkg/jwtkeys"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-// Claims represents JWT claims
-type Claims struct {
-	UserID string   `json:"sub"`
-	Roles  []string `json:"roles"`
-	jwt.RegisteredClaims
-}
+// Issuer is the iss claim of every token minted by the gateway
+const Issuer = "ekyc-api-gateway"
+
+// Claims represents JWT claims. The type is shared with the gRPC services so
+// that both sides agree on the token layout.
+type Claims = jwtkeys.Claims
 
 // JWTManager handles JWT operations
 type JWTManager struct {
-	secretKey []byte
+	keys      *jwtkeys.KeySet
+	verifier  *jwtkeys.Verifier
 	issuer    string
+	accessTTL time.Duration
 }
 
-// NewJWTManager creates a new JWT manager
-func NewJWTManager(secretKey string) *JWTManager {
+// NewJWTManager creates a new JWT manager that signs with the key set's
+// signing key and issues access tokens valid for accessTTL
+func NewJWTManager(keys *jwtkeys.KeySet, accessTTL time.Duration) *JWTManager {
 	return &JWTManager{
-		secretKey: []byte(secretKey),
-		issuer:    "ekyc-api-gateway",
+		keys:      keys,
+		verifier:  jwtkeys.NewVerifier(keys, Issuer),
+		issuer:    Issuer,
+		accessTTL: accessTTL,
 	}
 }
 
@@ -35,6 +42,7 @@ func (j *JWTManager) GenerateToken(userID string, roles []string, expiration tim
 		UserID: userID,
 		Roles:  roles,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			Issuer:    j.issuer,
 			Subject:   userID,
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -43,34 +51,19 @@ func (j *JWTManager) GenerateToken(userID string, roles []string, expiration tim
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(j.secretKey)
+	signing := j.keys.Signing()
+	if signing == nil {
+		return "", fmt.Errorf("no signing key configured")
+	}
+
+	token := jwt.NewWithClaims(signing.Method, claims)
+	token.Header["kid"] = signing.ID
+	return token.SignedString(signing.Key)
 }
 
 // ValidateToken validates and parses a JWT token
 func (j *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// Validate signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return j.secretKey, nil
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %w", err)
-	}
-
-	if !token.Valid {
-		return nil, fmt.Errorf("invalid token")
-	}
-
-	claims, ok := token.Claims.(*Claims)
-	if !ok {
-		return nil, fmt.Errorf("invalid token claims")
-	}
-
-	return claims, nil
+	return j.verifier.Verify(tokenString)
 }
 
 // HasRole checks if the user has a specific role
@@ -109,7 +102,12 @@ func (j *JWTManager) ExtractUserIDFromToken(tokenString string) (string, error)
 	return claims.UserID, nil
 }
 
-// TokenExpiration returns the default token expiration time
+// JWKS returns the public verification keys as a JSON Web Key Set
+func (j *JWTManager) JWKS() jwtkeys.JWKS {
+	return j.keys.JWKS()
+}
+
+// TokenExpiration returns the access token lifetime
 func (j *JWTManager) TokenExpiration() time.Duration {
-	return 24 * time.Hour // 24 hours
+	return j.accessTTL
 }
@@ -0,0 +1,184 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/google/uuid"
+)
+
+const (
+	refreshTokenBytes   = 32
+	refreshTokenPrefix  = "refresh:token:"
+	refreshUsedPrefix   = "refresh:used:"
+	refreshFamilyPrefix = "refresh:family:"
+)
+
+var (
+	// ErrInvalidRefreshToken is returned for unknown, expired or revoked refresh tokens
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused is returned when an already rotated refresh token is
+	// presented again. The whole token family is revoked when this happens.
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// RefreshToken is the server-side record behind an opaque refresh token.
+// It holds no roles: they are read from the user record on every refresh.
+type RefreshToken struct {
+	UserID   string    `json:"userId"`
+	FamilyID string    `json:"familyId"`
+	IssuedAt time.Time `json:"issuedAt"`
+}
+
+// RefreshTokenStore issues and rotates opaque refresh tokens backed by Redis.
+//
+// Every signin starts a token family. Refreshing consumes the presented token
+// and issues a new one in the same family; presenting a consumed token again
+// revokes the family so a stolen token cannot outlive the legitimate one.
+// Only a SHA-256 digest of each token is stored.
+type RefreshTokenStore struct {
+	redis *storage.Redis
+	ttl   time.Duration
+}
+
+// NewRefreshTokenStore creates a new refresh token store
+func NewRefreshTokenStore(redis *storage.Redis, ttl time.Duration) *RefreshTokenStore {
+	return &RefreshTokenStore{
+		redis: redis,
+		ttl:   ttl,
+	}
+}
+
+// TTL returns the refresh token lifetime
+func (s *RefreshTokenStore) TTL() time.Duration {
+	return s.ttl
+}
+
+// Issue starts a new token family for a user and returns its first refresh token
+func (s *RefreshTokenStore) Issue(ctx context.Context, userID string) (string, error) {
+	familyID := uuid.New().String()
+
+	if err := s.redis.Set(ctx, refreshFamilyPrefix+familyID, userID, s.ttl); err != nil {
+		return "", fmt.Errorf("failed to store token family: %w", err)
+	}
+
+	return s.issue(ctx, RefreshToken{
+		UserID:   userID,
+		FamilyID: familyID,
+	})
+}
+
+// Rotate consumes a refresh token and returns its record together with a
+// replacement token in the same family
+func (s *RefreshTokenStore) Rotate(ctx context.Context, token string) (*RefreshToken, string, error) {
+	digest := digestToken(token)
+
+	record, err := s.lookup(ctx, digest)
+	if err != nil {
+		return nil, "", err
+	}
+
+	first, err := s.redis.SetNX(ctx, refreshUsedPrefix+digest, "1", s.ttl)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+	if !first {
+		if err := s.RevokeFamily(ctx, record.FamilyID); err != nil {
+			return nil, "", err
+		}
+		return record, "", ErrRefreshTokenReused
+	}
+
+	if err := s.redis.Expire(ctx, refreshFamilyPrefix+record.FamilyID, s.ttl); err != nil {
+		return nil, "", fmt.Errorf("failed to extend token family: %w", err)
+	}
+
+	next, err := s.issue(ctx, RefreshToken{
+		UserID:   record.UserID,
+		FamilyID: record.FamilyID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return record, next, nil
+}
+
+// Revoke revokes the family the given refresh token belongs to
+func (s *RefreshTokenStore) Revoke(ctx context.Context, token string) error {
+	record, err := s.lookup(ctx, digestToken(token))
+	if err != nil {
+		return err
+	}
+
+	return s.RevokeFamily(ctx, record.FamilyID)
+}
+
+// RevokeFamily invalidates every refresh token in a family
+func (s *RefreshTokenStore) RevokeFamily(ctx context.Context, familyID string) error {
+	if err := s.redis.Del(ctx, refreshFamilyPrefix+familyID); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+	return nil
+}
+
+// issue generates a token for the record and stores it under its digest
+func (s *RefreshTokenStore) issue(ctx context.Context, record RefreshToken) (string, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	record.IssuedAt = time.Now().UTC()
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal refresh token: %w", err)
+	}
+
+	if err := s.redis.Set(ctx, refreshTokenPrefix+digestToken(token), data, s.ttl); err != nil {
+		return "", fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return token, nil
+}
+
+// lookup loads a token record and checks that its family is still active
+func (s *RefreshTokenStore) lookup(ctx context.Context, digest string) (*RefreshToken, error) {
+	data, err := s.redis.Get(ctx, refreshTokenPrefix+digest)
+	if err != nil {
+		if storage.IsNil(err) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to load refresh token: %w", err)
+	}
+
+	var record RefreshToken
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal refresh token: %w", err)
+	}
+
+	active, err := s.redis.Exists(ctx, refreshFamilyPrefix+record.FamilyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check token family: %w", err)
+	}
+	if active == 0 {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return &record, nil
+}
+
+// digestToken returns the hex SHA-256 digest used as the storage key
+func digestToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/ekyc-backend/pkg/logger"
 	"github.com/ekyc-backend/pkg/storage"
 	"github.com/ekyc-backend/services/api-gateway/internal/config"
-	"github.com/ekyc-backend/services/api-gateway/internal/handlers"
 	"github.com/ekyc-backend/services/api-gateway/internal/metrics"
 	"github.com/ekyc-backend/services/api-gateway/internal/middleware"
 	"github.com/ekyc-backend/services/api-gateway/internal/security"
@@ -16,14 +15,67 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// HealthRoutes serves the liveness and readiness endpoints
+type HealthRoutes interface {
+	Live(w http.ResponseWriter, r *http.Request)
+	Ready(w http.ResponseWriter, r *http.Request)
+}
+
+// JWKSRoutes serves the public token verification keys
+type JWKSRoutes interface {
+	JWKS(w http.ResponseWriter, r *http.Request)
+}
+
+// AuthRoutes serves the authentication endpoints
+type AuthRoutes interface {
+	SignUp(w http.ResponseWriter, r *http.Request)
+	SignIn(w http.ResponseWriter, r *http.Request)
+	Refresh(w http.ResponseWriter, r *http.Request)
+	Logout(w http.ResponseWriter, r *http.Request)
+}
+
+// RevocationRoutes serves the admin token revocation endpoints
+type RevocationRoutes interface {
+	RevokeTokens(w http.ResponseWriter, r *http.Request)
+}
+
+// AdminRoutes serves the admin session review endpoints
+type AdminRoutes interface {
+	ListSessions(w http.ResponseWriter, r *http.Request)
+	GetSessionDetail(w http.ResponseWriter, r *http.Request)
+	ApplyDecision(w http.ResponseWriter, r *http.Request)
+}
+
+// ScoringRoutes serves the admin scoring endpoints
+type ScoringRoutes interface {
+	GetShadowReport(w http.ResponseWriter, r *http.Request)
+}
+
+// EKYCRoutes serves the eKYC session endpoints
+type EKYCRoutes interface {
+	CreateSession(w http.ResponseWriter, r *http.Request)
+	UploadDocument(w http.ResponseWriter, r *http.Request)
+	UploadSelfie(w http.ResponseWriter, r *http.Request)
+	UploadLiveness(w http.ResponseWriter, r *http.Request)
+	GetLivenessChallenge(w http.ResponseWriter, r *http.Request)
+	GetSessionStatus(w http.ResponseWriter, r *http.Request)
+}
+
 // Server represents the HTTP server
 type Server struct {
 	config        *config.Config
 	logger        *logger.Logger
 	redisClient   *storage.Redis
 	jwtManager    *security.JWTManager
+	denylist      *security.Denylist
 	metrics       *metrics.Metrics
-	healthHandler *handlers.HealthHandler
+	healthHandler HealthRoutes
+	jwksHandler   JWKSRoutes
+	authHandler   AuthRoutes
+	ekycHandler   EKYCRoutes
+	revocation    RevocationRoutes
+	admin         AdminRoutes
+	scoring       ScoringRoutes
 	server        *http.Server
 	router        *chi.Mux
 }
@@ -34,16 +86,30 @@ func NewServer(
 	logger *logger.Logger,
 	redisClient *storage.Redis,
 	jwtManager *security.JWTManager,
+	denylist *security.Denylist,
 	metrics *metrics.Metrics,
-	healthHandler *handlers.HealthHandler,
+	healthHandler HealthRoutes,
+	jwksHandler JWKSRoutes,
+	authHandler AuthRoutes,
+	ekycHandler EKYCRoutes,
+	revocation RevocationRoutes,
+	admin AdminRoutes,
+	scoring ScoringRoutes,
 ) *Server {
 	s := &Server{
 		config:        cfg,
 		logger:        logger,
 		redisClient:   redisClient,
 		jwtManager:    jwtManager,
+		denylist:      denylist,
 		metrics:       metrics,
 		healthHandler: healthHandler,
+		jwksHandler:   jwksHandler,
+		authHandler:   authHandler,
+		ekycHandler:   ekycHandler,
+		revocation:    revocation,
+		admin:         admin,
+		scoring:       scoring,
 	}
 
 	s.setupRouter()
@@ -86,27 +152,42 @@ func (s *Server) setupRouter() {
 	s.router.Get("/ready", s.healthHandler.Ready)
 	s.router.Handle(s.config.PrometheusMetricsPath, promhttp.Handler())
 
+	// Token verification keys (no auth required)
+	s.router.Get("/.well-known/jwks.json", s.jwksHandler.JWKS)
+
 	// API routes
 	s.router.Route("/api/v1", func(r chi.Router) {
 		// Auth routes (no auth required)
 		r.Route("/auth", func(r chi.Router) {
-			// TODO: Add auth handlers
+			r.Post("/signup", s.authHandler.SignUp)
+			r.Post("/signin", s.authHandler.SignIn)
+			r.Post("/refresh", s.authHandler.Refresh)
+			r.Post("/logout", s.authHandler.Logout)
 		})
 
 		// eKYC routes (require USER or ADMIN role)
 		r.Route("/ekyc", func(r chi.Router) {
-			r.Use(middleware.Auth(s.jwtManager, s.logger))
+			r.Use(middleware.Auth(s.jwtManager, s.denylist, s.logger))
 			r.Use(middleware.RequireAnyRole("USER", "ADMIN"))
 
-			// TODO: Add eKYC handlers
+			r.Post("/session", s.ekycHandler.CreateSession)
+			r.Post("/{id}/document", s.ekycHandler.UploadDocument)
+			r.Post("/{id}/selfie", s.ekycHandler.UploadSelfie)
+			r.Post("/{id}/liveness", s.ekycHandler.UploadLiveness)
+			r.Get("/{id}/liveness/challenge", s.ekycHandler.GetLivenessChallenge)
+			r.Get("/{id}/status", s.ekycHandler.GetSessionStatus)
 		})
 
 		// Admin routes (require ADMIN role)
 		r.Route("/admin", func(r chi.Router) {
-			r.Use(middleware.Auth(s.jwtManager, s.logger))
+			r.Use(middleware.Auth(s.jwtManager, s.denylist, s.logger))
 			r.Use(middleware.RequireRole("ADMIN", s.logger))
 
-			// TODO: Add admin handlers
+			r.Get("/sessions", s.admin.ListSessions)
+			r.Get("/sessions/{id}", s.admin.GetSessionDetail)
+			r.Post("/sessions/{id}/decision", s.admin.ApplyDecision)
+			r.Post("/tokens/revoke", s.revocation.RevokeTokens)
+			r.Get("/scoring/shadow-report", s.scoring.GetShadowReport)
 		})
 	})
 }
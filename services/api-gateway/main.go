@@ -9,7 +9,9 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ekyc-backend/pkg/jwtkeys"
 	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
 	"github.com/ekyc-backend/pkg/otel"
 	"github.com/ekyc-backend/pkg/storage"
 	"github.com/ekyc-backend/services/api-gateway/internal/clients"
@@ -18,6 +20,7 @@ import (
 	"github.com/ekyc-backend/services/api-gateway/internal/metrics"
 	"github.com/ekyc-backend/services/api-gateway/internal/security"
 	"github.com/ekyc-backend/services/api-gateway/internal/server"
+	"go.uber.org/zap"
 )
 
 func main() {
@@ -34,57 +37,95 @@ func main() {
 	logger.Info("Starting API Gateway")
 
 	// Initialize OpenTelemetry
-	if err := otel.InitTracer(cfg, cfg.ServiceName); err != nil {
-		logger.Error("Failed to initialize OpenTelemetry tracer")
+	tp, err := otel.InitTracer(cfg.TelemetryConfig())
+	if err != nil {
+		logger.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
 	}
 
-	if err := otel.InitMeter(cfg, cfg.ServiceName); err != nil {
-		logger.Error("Failed to initialize OpenTelemetry meter")
+	mp, err := otel.InitMeter(cfg.TelemetryConfig())
+	if err != nil {
+		logger.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
 	}
 
 	// Initialize Redis client
-	redisClient, err := storage.NewRedis(cfg, logger)
+	redisClient, err := storage.NewRedisFromURL(cfg.RedisURL, logger)
 	if err != nil {
-		logger.Fatal("Failed to connect to Redis")
+		logger.Fatal("Failed to connect to Redis", zap.Error(err))
 	}
 	defer redisClient.Close()
 
+	// Initialize mTLS for gRPC clients
+	tlsSource, err := mtls.Load(cfg.TLSOptions(), logger)
+	if err != nil {
+		logger.Fatal("Failed to load mTLS configuration", zap.Error(err))
+	}
+	if tlsSource != nil {
+		defer tlsSource.Close()
+	}
+
 	// Initialize gRPC clients
-	identityClient, err := clients.NewIdentityClient(cfg.IdentityGRPCAddr, logger)
+	identityClient, err := clients.NewIdentityClient(cfg.IdentityGRPCAddr, tlsSource, logger)
 	if err != nil {
-		logger.Fatal("Failed to create identity client")
+		logger.Fatal("Failed to create identity client", zap.Error(err))
 	}
 	defer identityClient.Close()
 
-	storageClient, err := clients.NewStorageClient(cfg.StorageGRPCAddr, logger)
+	storageClient, err := clients.NewStorageClient(cfg.StorageGRPCAddr, tlsSource, logger)
 	if err != nil {
-		logger.Fatal("Failed to create storage client")
+		logger.Fatal("Failed to create storage client", zap.Error(err))
 	}
 	defer storageClient.Close()
 
-	adminClient, err := clients.NewAdminClient(cfg.AdminGRPCAddr, logger)
+	adminClient, err := clients.NewAdminClient(cfg.AdminGRPCAddr, tlsSource, logger)
 	if err != nil {
-		logger.Fatal("Failed to create admin client")
+		logger.Fatal("Failed to create admin client", zap.Error(err))
 	}
 	defer adminClient.Close()
 
-	// Initialize JWT manager
-	jwtManager := security.NewJWTManager(cfg.JWTSecret)
+	livenessClient, err := clients.NewLivenessClient(cfg.LivenessGRPCAddr, tlsSource, logger)
+	if err != nil {
+		logger.Fatal("Failed to create liveness client", zap.Error(err))
+	}
+	defer livenessClient.Close()
+
+	scoringClient, err := clients.NewScoringClient(cfg.ScoringGRPCAddr, tlsSource, logger)
+	if err != nil {
+		logger.Fatal("Failed to create scoring client", zap.Error(err))
+	}
+	defer scoringClient.Close()
+
+	// Initialize JWT signing keys, JWT manager and refresh token store
+	keys, err := loadKeySet(cfg, logger)
+	if err != nil {
+		logger.Fatal("Failed to load JWT keys", zap.Error(err))
+	}
+	jwtManager := security.NewJWTManager(keys, cfg.AccessTokenTTL)
+	refreshTokens := security.NewRefreshTokenStore(redisClient, cfg.RefreshTokenTTL)
+	denylist := security.NewDenylist(redisClient, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
 
 	// Initialize metrics
 	metrics := metrics.NewMetrics()
 
+	// Initialize request validator
+	validator := server.NewValidator(logger)
+
 	// Initialize handlers
 	healthHandler := handlers.NewHealthHandler(logger, identityClient, storageClient, adminClient)
+	jwksHandler := handlers.NewJWKSHandler(jwtManager)
+	authHandler := handlers.NewAuthHandler(logger, validator, identityClient, jwtManager, refreshTokens, denylist)
+	ekycHandler := handlers.NewEKYCHandler(logger, validator, identityClient, storageClient, livenessClient)
+	revocationHandler := handlers.NewRevocationHandler(logger, validator, denylist)
+	adminHandler := handlers.NewAdminHandler(logger, validator, adminClient)
+	scoringHandler := handlers.NewScoringHandler(logger, validator, scoringClient)
 
 	// Initialize server
-	srv := server.NewServer(cfg, logger, redisClient, jwtManager, metrics, healthHandler)
+	srv := server.NewServer(cfg, logger, redisClient, jwtManager, denylist, metrics, healthHandler, jwksHandler, authHandler, ekycHandler, revocationHandler, adminHandler, scoringHandler)
 
 	// Start server
 	go func() {
-		logger.Info("Starting HTTP server", "addr", cfg.GetHTTPAddr())
+		logger.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
 		if err := srv.Start(); err != nil && err != http.ErrServerClosed {
-			logger.Fatal("Failed to start server", "error", err)
+			logger.Fatal("Failed to start server", zap.Error(err))
 		}
 	}()
 
@@ -100,8 +141,44 @@ func main() {
 	defer cancel()
 
 	if err := srv.Shutdown(ctx); err != nil {
-		logger.Error("Server forced to shutdown", "error", err)
+		logger.Error("Server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		logger.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
 	}
 
 	logger.Info("Server exited")
 }
+
+// loadKeySet loads the JWT signing key and any retired verification keys.
+// In development an ephemeral key is generated when none is configured.
+func loadKeySet(cfg *config.Config, log *logger.Logger) (*jwtkeys.KeySet, error) {
+	var (
+		signing *jwtkeys.SigningKey
+		err     error
+	)
+
+	if cfg.JWTSigningKeyFile != "" {
+		signing, err = jwtkeys.LoadSigningKey(cfg.JWTSigningKeyFile, cfg.JWTSigningKeyID)
+	} else {
+		log.Warn("No JWT signing key configured, generating an ephemeral development key")
+		signing, err = jwtkeys.GenerateSigningKey("dev")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := jwtkeys.LoadVerificationKeys(cfg.JWTVerificationKeysDir)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("JWT keys loaded",
+		zap.String("signing_kid", signing.ID),
+		zap.String("alg", signing.Method.Alg()),
+		zap.Int("verification_keys", len(verification)),
+	)
+
+	return jwtkeys.NewKeySet(signing, verification), nil
+}
@@ -4,7 +4,10 @@ go 1.22
 
 require (
 	github.com/ekyc-backend/pkg v0.0.0
+	github.com/gin-gonic/gin v1.9.1
 	github.com/nats-io/nats.go v1.33.1
+	go.uber.org/zap v1.26.0
+	google.golang.org/protobuf v1.32.0
 )
 
 replace github.com/ekyc-backend/pkg => ../../pkg
@@ -0,0 +1,42 @@
+package extractor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/config"
+)
+
+// Engine names accepted in OCR_ENGINE
+const (
+	EngineTesseract = "tesseract"
+	EngineFixture   = "fixture"
+)
+
+// Extraction is the raw text recognized on a document image
+type Extraction struct {
+	// Text holds the recognized lines, separated by newlines
+	Text string `json:"text"`
+	// Confidence is the mean recognition confidence in [0, 1]
+	Confidence float32 `json:"confidence"`
+}
+
+// Extractor recognizes the text on a document image
+type Extractor interface {
+	// Name identifies the engine in stored results
+	Name() string
+	// Extract runs recognition on an encoded image
+	Extract(ctx context.Context, image []byte) (*Extraction, error)
+}
+
+// New builds the extractor selected by the configuration
+func New(cfg *config.Config) (Extractor, error) {
+	switch cfg.OCREngine {
+	case EngineTesseract:
+		return NewTesseract(cfg.OCRTesseractPath, cfg.OCRLanguages), nil
+	case EngineFixture:
+		return LoadFixtures(cfg.OCRFixtureDir)
+	default:
+		return nil, fmt.Errorf("unsupported OCR engine %q", cfg.OCREngine)
+	}
+}
@@ -0,0 +1,102 @@
+package extractor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultFixture is returned for unknown images when no default.json is given
+var defaultFixture = Extraction{
+	Text: strings.Join([]string{
+		"CĂN CƯỚC CÔNG DÂN",
+		"Số / No.: 001090000001",
+		"Họ và tên / Full name:",
+		"NGUYỄN VĂN A",
+		"Ngày sinh / Date of birth: 01/01/1990",
+		"Giới tính / Sex: Nam",
+		"Quốc tịch / Nationality: Việt Nam",
+		"Quê quán / Place of origin: Hà Nội",
+		"Nơi thường trú / Place of residence: 1 Tràng Tiền, Hoàn Kiếm, Hà Nội",
+		"Có giá trị đến / Date of expiry: 01/01/2030",
+	}, "\n"),
+	Confidence: 0.95,
+}
+
+// Fixtures returns canned extractions keyed by the SHA-256 of the image. It
+// makes OCR deterministic for tests and local runs without tesseract.
+type Fixtures struct {
+	byDigest map[string]Extraction
+	fallback Extraction
+}
+
+// NewFixtures creates a fixture extractor from in-memory extractions keyed by
+// hex SHA-256 digest
+func NewFixtures(byDigest map[string]Extraction, fallback Extraction) *Fixtures {
+	return &Fixtures{byDigest: byDigest, fallback: fallback}
+}
+
+// LoadFixtures reads {sha256}.json files from dir, plus an optional
+// default.json for images without a fixture. An empty dir yields the built-in
+// sample for every image.
+func LoadFixtures(dir string) (*Fixtures, error) {
+	fixtures := NewFixtures(map[string]Extraction{}, defaultFixture)
+	if dir == "" {
+		return fixtures, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fixtures: %w", err)
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+
+		var extraction Extraction
+		if err := json.Unmarshal(data, &extraction); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %w", filepath.Base(path), err)
+		}
+
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		if name == "default" {
+			fixtures.fallback = extraction
+			continue
+		}
+		fixtures.byDigest[strings.ToLower(name)] = extraction
+	}
+
+	if len(paths) == 0 {
+		if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("fixture directory %s does not exist", dir)
+		}
+	}
+
+	return fixtures, nil
+}
+
+// Name identifies the engine in stored results
+func (f *Fixtures) Name() string {
+	return EngineFixture
+}
+
+// Extract returns the fixture registered for the image digest
+func (f *Fixtures) Extract(_ context.Context, image []byte) (*Extraction, error) {
+	digest := sha256.Sum256(image)
+	if extraction, ok := f.byDigest[hex.EncodeToString(digest[:])]; ok {
+		return &extraction, nil
+	}
+
+	extraction := f.fallback
+	return &extraction, nil
+}
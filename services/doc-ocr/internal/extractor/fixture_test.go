@@ -0,0 +1,135 @@
+package extractor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFixturesExtract(t *testing.T) {
+	known := []byte("front of a card")
+	digest := sha256.Sum256(known)
+
+	fixtures := NewFixtures(
+		map[string]Extraction{hex.EncodeToString(digest[:]): {Text: "known", Confidence: 0.9}},
+		Extraction{Text: "fallback", Confidence: 0.5},
+	)
+
+	tests := []struct {
+		name  string
+		image []byte
+		want  string
+	}{
+		{name: "registered image", image: known, want: "known"},
+		{name: "unknown image", image: []byte("other"), want: "fallback"},
+		{name: "empty image", image: nil, want: "fallback"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fixtures.Extract(context.Background(), tt.image)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+			if got.Text != tt.want {
+				t.Errorf("Extract() text = %q, want %q", got.Text, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixturesExtractReturnsCopies(t *testing.T) {
+	fixtures := NewFixtures(map[string]Extraction{}, Extraction{Text: "fallback"})
+
+	first, _ := fixtures.Extract(context.Background(), nil)
+	first.Text = "changed"
+
+	second, _ := fixtures.Extract(context.Background(), nil)
+	if second.Text != "fallback" {
+		t.Errorf("Extract() text = %q after mutating an earlier result, want %q", second.Text, "fallback")
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	image := []byte("passport page")
+	digest := sha256.Sum256(image)
+	name := hex.EncodeToString(digest[:])
+
+	dir := t.TempDir()
+	writeFixture(t, dir, "default.json", `{"text": "default", "confidence": 0.4}`)
+	// Digests are matched case-insensitively
+	writeFixture(t, dir, strings.ToUpper(name)+".json", `{"text": "passport", "confidence": 0.8}`)
+
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		t.Fatalf("LoadFixtures() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		image []byte
+		want  string
+	}{
+		{name: "fixture by digest", image: image, want: "passport"},
+		{name: "default.json", image: []byte("other"), want: "default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fixtures.Extract(context.Background(), tt.image)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+			if got.Text != tt.want {
+				t.Errorf("Extract() text = %q, want %q", got.Text, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFixturesErrors(t *testing.T) {
+	malformed := t.TempDir()
+	writeFixture(t, malformed, "default.json", `{"text":`)
+
+	tests := []struct {
+		name string
+		dir  string
+	}{
+		{name: "missing directory", dir: filepath.Join(t.TempDir(), "missing")},
+		{name: "malformed fixture", dir: malformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadFixtures(tt.dir); err == nil {
+				t.Error("LoadFixtures() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestLoadFixturesWithoutDirectory(t *testing.T) {
+	fixtures, err := LoadFixtures("")
+	if err != nil {
+		t.Fatalf("LoadFixtures() error = %v", err)
+	}
+
+	got, err := fixtures.Extract(context.Background(), []byte("anything"))
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if got.Text != defaultFixture.Text {
+		t.Errorf("Extract() text = %q, want the built-in sample", got.Text)
+	}
+}
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+}
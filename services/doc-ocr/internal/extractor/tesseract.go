@@ -0,0 +1,102 @@
+package extractor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Tesseract runs the tesseract CLI on the local CPU. Images are passed on
+// stdin and results read as TSV, so nothing touches the disk.
+type Tesseract struct {
+	path      string
+	languages string
+}
+
+// NewTesseract creates an extractor running the tesseract binary at path
+// with the given "+"-separated language list
+func NewTesseract(path, languages string) *Tesseract {
+	return &Tesseract{path: path, languages: languages}
+}
+
+// Name identifies the engine in stored results
+func (t *Tesseract) Name() string {
+	return EngineTesseract
+}
+
+// Extract runs tesseract on an encoded image
+func (t *Tesseract) Extract(ctx context.Context, image []byte) (*Extraction, error) {
+	// --psm 6 treats the card as a single block of text, which keeps the
+	// label and value lines of ID documents in reading order
+	cmd := exec.CommandContext(ctx, t.path, "stdin", "stdout", "-l", t.languages, "--psm", "6", "tsv")
+	cmd.Stdin = bytes.NewReader(image)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("tesseract failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseTSV(&stdout)
+}
+
+// parseTSV rebuilds the text lines and mean word confidence from tesseract's
+// TSV output
+func parseTSV(output *bytes.Buffer) (*Extraction, error) {
+	var (
+		lines      []string
+		current    []string
+		currentKey string
+		confSum    float64
+		words      int
+	)
+
+	scanner := bufio.NewScanner(output)
+	for first := true; scanner.Scan(); first = false {
+		if first {
+			continue // header row
+		}
+
+		// level page block par line word left top width height conf text
+		cols := strings.Split(scanner.Text(), "\t")
+		if len(cols) < 12 {
+			continue
+		}
+
+		conf, err := strconv.ParseFloat(cols[10], 64)
+		text := strings.TrimSpace(cols[11])
+		if err != nil || conf < 0 || text == "" {
+			continue
+		}
+
+		key := strings.Join(cols[1:5], "/")
+		if key != currentKey && len(current) > 0 {
+			lines = append(lines, strings.Join(current, " "))
+			current = nil
+		}
+		currentKey = key
+		current = append(current, text)
+
+		confSum += conf
+		words++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tesseract output: %w", err)
+	}
+	if len(current) > 0 {
+		lines = append(lines, strings.Join(current, " "))
+	}
+
+	extraction := &Extraction{Text: strings.Join(lines, "\n")}
+	if words > 0 {
+		extraction.Confidence = float32(confSum / float64(words) / 100)
+	}
+
+	return extraction, nil
+}
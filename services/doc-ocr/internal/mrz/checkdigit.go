@@ -0,0 +1,35 @@
+package mrz
+
+// weights are the repeating check digit weights of ICAO 9303
+var weights = [3]int{7, 3, 1}
+
+// CheckDigit computes the ICAO 9303 check digit of an MRZ field. Digits
+// count as themselves, letters A-Z as 10-35 and the filler as zero.
+func CheckDigit(value string) byte {
+	sum := 0
+	for i := 0; i < len(value); i++ {
+		sum += charValue(value[i]) * weights[i%3]
+	}
+	return byte('0' + sum%10)
+}
+
+// Verify reports whether digit is the check digit of value. A misread 'O'
+// in the check digit position is read as zero.
+func Verify(value string, digit byte) bool {
+	if digit == 'O' {
+		digit = '0'
+	}
+	return CheckDigit(value) == digit
+}
+
+// charValue returns the numeric value of an MRZ character
+func charValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	default:
+		return 0
+	}
+}
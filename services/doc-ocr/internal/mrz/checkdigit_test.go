@@ -0,0 +1,48 @@
+package mrz
+
+import "testing"
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  byte
+	}{
+		{"L898902C3", '6'},
+		{"740812", '2'},
+		{"120415", '9'},
+		{"D23145890734", '9'},
+		{"ZE184226B<<<<<", '1'},
+		{"<<<<<<<<<", '0'},
+		{"", '0'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := CheckDigit(tt.value); got != tt.want {
+				t.Errorf("CheckDigit(%q) = %c, want %c", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		digit byte
+		want  bool
+	}{
+		{name: "matching digit", value: "740812", digit: '2', want: true},
+		{name: "wrong digit", value: "740812", digit: '3'},
+		{name: "O misread for zero", value: "<<<<<<<<<", digit: 'O', want: true},
+		{name: "filler is not zero", value: "<<<<<<<<<", digit: '<'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.value, tt.digit); got != tt.want {
+				t.Errorf("Verify(%q, %c) = %v, want %v", tt.value, tt.digit, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package mrz
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Document formats defined by ICAO 9303
+const (
+	// FormatTD1 is the three-line, 30-character format of ID cards
+	FormatTD1 = "TD1"
+	// FormatTD2 is the two-line, 36-character format of older ID cards and visas
+	FormatTD2 = "TD2"
+	// FormatTD3 is the two-line, 44-character format of passports
+	FormatTD3 = "TD3"
+)
+
+// Fields whose check digits are verified
+const (
+	CheckDocumentNumber = "document_number"
+	CheckDateOfBirth    = "dob"
+	CheckExpiryDate     = "expiry_date"
+	CheckOptionalData   = "optional_data"
+	CheckComposite      = "composite"
+)
+
+// DateLayout is the layout of the dates returned in MRZ
+const DateLayout = "2006-01-02"
+
+var (
+	// ErrNotFound is returned when no machine-readable zone is present
+	ErrNotFound = errors.New("no MRZ found")
+	// ErrMalformed is returned for lines that do not follow an MRZ layout
+	ErrMalformed = errors.New("malformed MRZ")
+)
+
+// MRZ is a parsed machine-readable zone
+type MRZ struct {
+	Format         string
+	DocumentCode   string
+	Issuer         string
+	DocumentNumber string
+	Nationality    string
+	DateOfBirth    string
+	Sex            string
+	ExpiryDate     string
+	Surname        string
+	GivenNames     string
+	// Optional holds the optional data fields, in order, without filler
+	Optional []string
+	// FailedChecks lists the fields whose check digit did not match
+	FailedChecks []string
+}
+
+// Valid reports whether every check digit matched
+func (m *MRZ) Valid() bool {
+	return len(m.FailedChecks) == 0
+}
+
+// FullName returns the holder's name as "SURNAME GIVEN NAMES"
+func (m *MRZ) FullName() string {
+	return strings.TrimSpace(m.Surname + " " + m.GivenNames)
+}
+
+// PersonalNumber returns the national identity number carried in the
+// optional data of ID cards and some passports, or "" when absent
+func (m *MRZ) PersonalNumber() string {
+	for _, optional := range m.Optional {
+		if optional != "" {
+			return optional
+		}
+	}
+	return ""
+}
+
+// Find locates a machine-readable zone in OCR text and parses it
+func Find(text string) (*MRZ, error) {
+	var candidates []string
+	for _, line := range strings.Split(text, "\n") {
+		if candidate, ok := candidateLine(line); ok {
+			candidates = append(candidates, candidate)
+			continue
+		}
+		if m, err := parseCandidates(candidates); err == nil {
+			return m, nil
+		}
+		candidates = nil
+	}
+
+	if m, err := parseCandidates(candidates); err == nil {
+		return m, nil
+	}
+	return nil, ErrNotFound
+}
+
+// Parse parses the lines of a machine-readable zone
+func Parse(lines []string) (*MRZ, error) {
+	switch {
+	case len(lines) == 3 && allLength(lines, 30):
+		return parseTD1(lines)
+	case len(lines) == 2 && allLength(lines, 36):
+		return parseTD2(lines)
+	case len(lines) == 2 && allLength(lines, 44):
+		return parseTD3(lines)
+	default:
+		return nil, fmt.Errorf("%w: unsupported line layout", ErrMalformed)
+	}
+}
+
+// parseCandidates tries the trailing lines of a run of MRZ-like lines
+func parseCandidates(lines []string) (*MRZ, error) {
+	for _, n := range []int{3, 2} {
+		if len(lines) >= n {
+			if m, err := Parse(lines[len(lines)-n:]); err == nil {
+				return m, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// candidateLine cleans a line of OCR text and reports whether it looks like
+// an MRZ line
+func candidateLine(line string) (string, bool) {
+	line = strings.Map(func(r rune) rune {
+		switch {
+		case r == ' ' || r == '\t':
+			return -1
+		case r == '«' || r == '‹':
+			return '<'
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		}
+		return r
+	}, strings.TrimSpace(line))
+
+	switch len(line) {
+	case 30, 36, 44:
+	default:
+		return "", false
+	}
+	if !strings.Contains(line, "<") {
+		return "", false
+	}
+	for _, r := range line {
+		if !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') && r != '<' {
+			return "", false
+		}
+	}
+	return line, true
+}
+
+// parseTD1 parses a three-line ID card zone
+func parseTD1(lines []string) (*MRZ, error) {
+	l1, l2, l3 := lines[0], lines[1], lines[2]
+
+	m := &MRZ{
+		Format:       FormatTD1,
+		DocumentCode: field(l1[0:2]),
+		Issuer:       NormalizeCountry(l1[2:5]),
+		Nationality:  NormalizeCountry(l2[15:18]),
+		Sex:          NormalizeSex(l2[7]),
+	}
+
+	number, numberCheck, optional1 := l1[5:14], l1[14], l1[15:30]
+	if numberCheck == '<' {
+		// Document numbers longer than nine characters continue in the
+		// optional data, followed by their check digit
+		overflow := strings.TrimRight(optional1, "<")
+		if overflow == "" {
+			return nil, fmt.Errorf("%w: missing document number check digit", ErrMalformed)
+		}
+		number += overflow[:len(overflow)-1]
+		numberCheck = overflow[len(overflow)-1]
+		optional1 = ""
+	}
+	m.DocumentNumber = field(number)
+	m.check(CheckDocumentNumber, number, numberCheck)
+	m.Optional = []string{field(optional1), field(l2[18:29])}
+
+	m.DateOfBirth = m.date(CheckDateOfBirth, l2[0:6], l2[6], false)
+	m.ExpiryDate = m.date(CheckExpiryDate, l2[8:14], l2[14], true)
+	m.check(CheckComposite, l1[5:30]+l2[0:7]+l2[8:15]+l2[18:29], l2[29])
+
+	m.Surname, m.GivenNames = names(l3)
+	return m, nil
+}
+
+// parseTD2 parses a two-line, 36-character zone
+func parseTD2(lines []string) (*MRZ, error) {
+	l1, l2 := lines[0], lines[1]
+
+	m := &MRZ{
+		Format:       FormatTD2,
+		DocumentCode: field(l1[0:2]),
+		Issuer:       NormalizeCountry(l1[2:5]),
+		Nationality:  NormalizeCountry(l2[10:13]),
+		Sex:          NormalizeSex(l2[20]),
+		Optional:     []string{field(l2[28:35])},
+	}
+	m.Surname, m.GivenNames = names(l1[5:36])
+
+	number := l2[0:9]
+	m.DocumentNumber = field(number)
+	m.check(CheckDocumentNumber, number, l2[9])
+	m.DateOfBirth = m.date(CheckDateOfBirth, l2[13:19], l2[19], false)
+	m.ExpiryDate = m.date(CheckExpiryDate, l2[21:27], l2[27], true)
+	m.check(CheckComposite, l2[0:10]+l2[13:20]+l2[21:35], l2[35])
+
+	return m, nil
+}
+
+// parseTD3 parses a passport zone
+func parseTD3(lines []string) (*MRZ, error) {
+	l1, l2 := lines[0], lines[1]
+	if l1[0] != 'P' {
+		return nil, fmt.Errorf("%w: TD3 document code must start with P", ErrMalformed)
+	}
+
+	m := &MRZ{
+		Format:       FormatTD3,
+		DocumentCode: field(l1[0:2]),
+		Issuer:       NormalizeCountry(l1[2:5]),
+		Nationality:  NormalizeCountry(l2[10:13]),
+		Sex:          NormalizeSex(l2[20]),
+		Optional:     []string{field(l2[28:42])},
+	}
+	m.Surname, m.GivenNames = names(l1[5:44])
+
+	number := l2[0:9]
+	m.DocumentNumber = field(number)
+	m.check(CheckDocumentNumber, number, l2[9])
+	m.DateOfBirth = m.date(CheckDateOfBirth, l2[13:19], l2[19], false)
+	m.ExpiryDate = m.date(CheckExpiryDate, l2[21:27], l2[27], true)
+	// An empty personal number may leave its check digit as filler
+	if l2[42] != '<' || strings.Trim(l2[28:42], "<") != "" {
+		m.check(CheckOptionalData, l2[28:42], l2[42])
+	}
+	m.check(CheckComposite, l2[0:10]+l2[13:20]+l2[21:43], l2[43])
+
+	return m, nil
+}
+
+// check verifies a check digit and records the field when it fails
+func (m *MRZ) check(name, value string, digit byte) {
+	if !Verify(value, digit) {
+		m.FailedChecks = append(m.FailedChecks, name)
+	}
+}
+
+// date verifies and converts a YYMMDD field into DateLayout. Birth dates
+// that would lie in the future are placed in the previous century; expiry
+// dates are always in this century.
+func (m *MRZ) date(name, value string, digit byte, expiry bool) string {
+	value = numeric(value)
+	m.check(name, value, digit)
+
+	t, err := time.Parse("060102", value)
+	if err != nil {
+		return ""
+	}
+
+	// time.Parse maps years 69-99 to the 1900s and 00-68 to the 2000s
+	year := 2000 + t.Year()%100
+	if !expiry && year > time.Now().Year() {
+		year -= 100
+	}
+
+	return time.Date(year, t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Format(DateLayout)
+}
+
+// names splits a name field into the primary and secondary identifiers
+func names(value string) (string, string) {
+	primary, secondary, _ := strings.Cut(strings.TrimRight(value, "<"), "<<")
+	return field(alphabetic(primary)), field(alphabetic(secondary))
+}
+
+// field turns filler characters into spaces and trims the result
+func field(value string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(value, "<", " ")), " ")
+}
+
+// allLength reports whether every line has length n
+func allLength(lines []string, n int) bool {
+	for _, line := range lines {
+		if len(line) != n {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,256 @@
+package mrz
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Specimens from ICAO 9303 and Vietnamese documents built the same way
+var (
+	td1 = []string{
+		"I<UTOD231458907<<<<<<<<<<<<<<<",
+		"7408122F1204159UTO<<<<<<<<<<<6",
+		"ERIKSSON<<ANNA<MARIA<<<<<<<<<<",
+	}
+	td1LongNumber = []string{
+		"I<UTOD23145890<7349<<<<<<<<<<<",
+		"7408122F1204159UTO<<<<<<<<<<<6",
+		"ERIKSSON<<ANNA<MARIA<<<<<<<<<<",
+	}
+	td1Citizen = []string{
+		"IDVNM0900000018001090000001<<<",
+		"9001011M3001019VNM<<<<<<<<<<<3",
+		"NGUYEN<<VAN<A<<<<<<<<<<<<<<<<<",
+	}
+	td2 = []string{
+		"I<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<",
+		"D231458907UTO7408122F1204159<<<<<<<6",
+	}
+	td3 = []string{
+		"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<",
+		"L898902C36UTO7408122F1204159ZE184226B<<<<<10",
+	}
+	td3Citizen = []string{
+		"P<VNMNGUYEN<<VAN<A<<<<<<<<<<<<<<<<<<<<<<<<<<",
+		"C1234567<0VNM9001011M3001019001090000001<<94",
+	}
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  MRZ
+	}{
+		{
+			name:  "TD1",
+			lines: td1,
+			want: MRZ{
+				Format: FormatTD1, DocumentCode: "I", Issuer: "UTO", DocumentNumber: "D23145890",
+				Nationality: "UTO", DateOfBirth: "1974-08-12", Sex: "F", ExpiryDate: "2012-04-15",
+				Surname: "ERIKSSON", GivenNames: "ANNA MARIA", Optional: []string{"", ""},
+			},
+		},
+		{
+			name:  "TD1 document number overflowing into optional data",
+			lines: td1LongNumber,
+			want: MRZ{
+				Format: FormatTD1, DocumentCode: "I", Issuer: "UTO", DocumentNumber: "D23145890734",
+				Nationality: "UTO", DateOfBirth: "1974-08-12", Sex: "F", ExpiryDate: "2012-04-15",
+				Surname: "ERIKSSON", GivenNames: "ANNA MARIA", Optional: []string{"", ""},
+			},
+		},
+		{
+			name:  "TD1 citizen identity card",
+			lines: td1Citizen,
+			want: MRZ{
+				Format: FormatTD1, DocumentCode: "ID", Issuer: "VNM", DocumentNumber: "090000001",
+				Nationality: "VNM", DateOfBirth: "1990-01-01", Sex: "M", ExpiryDate: "2030-01-01",
+				Surname: "NGUYEN", GivenNames: "VAN A", Optional: []string{"001090000001", ""},
+			},
+		},
+		{
+			name:  "TD2",
+			lines: td2,
+			want: MRZ{
+				Format: FormatTD2, DocumentCode: "I", Issuer: "UTO", DocumentNumber: "D23145890",
+				Nationality: "UTO", DateOfBirth: "1974-08-12", Sex: "F", ExpiryDate: "2012-04-15",
+				Surname: "ERIKSSON", GivenNames: "ANNA MARIA", Optional: []string{""},
+			},
+		},
+		{
+			name:  "TD3",
+			lines: td3,
+			want: MRZ{
+				Format: FormatTD3, DocumentCode: "P", Issuer: "UTO", DocumentNumber: "L898902C3",
+				Nationality: "UTO", DateOfBirth: "1974-08-12", Sex: "F", ExpiryDate: "2012-04-15",
+				Surname: "ERIKSSON", GivenNames: "ANNA MARIA", Optional: []string{"ZE184226B"},
+			},
+		},
+		{
+			name:  "TD3 with a personal number",
+			lines: td3Citizen,
+			want: MRZ{
+				Format: FormatTD3, DocumentCode: "P", Issuer: "VNM", DocumentNumber: "C1234567",
+				Nationality: "VNM", DateOfBirth: "1990-01-01", Sex: "M", ExpiryDate: "2030-01-01",
+				Surname: "NGUYEN", GivenNames: "VAN A", Optional: []string{"001090000001"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.lines)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", *got, tt.want)
+			}
+			if !got.Valid() {
+				t.Errorf("Parse() failed checks %v", got.FailedChecks)
+			}
+		})
+	}
+}
+
+func TestParseFailedChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []string
+	}{
+		{
+			name:  "TD1 document number",
+			lines: replace(td1, 0, 14, '8'),
+			want:  []string{CheckDocumentNumber, CheckComposite},
+		},
+		{
+			name:  "TD1 composite only",
+			lines: replace(td1, 1, 29, '7'),
+			want:  []string{CheckComposite},
+		},
+		{
+			name:  "TD2 date of birth",
+			lines: replace(td2, 1, 19, '3'),
+			want:  []string{CheckDateOfBirth, CheckComposite},
+		},
+		{
+			name:  "TD3 expiry date",
+			lines: replace(td3, 1, 27, '8'),
+			want:  []string{CheckExpiryDate, CheckComposite},
+		},
+		{
+			name:  "TD3 personal number",
+			lines: replace(td3, 1, 42, '2'),
+			want:  []string{CheckOptionalData, CheckComposite},
+		},
+		{
+			name:  "TD3 composite only",
+			lines: replace(td3, 1, 43, '1'),
+			want:  []string{CheckComposite},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.lines)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.FailedChecks, tt.want) {
+				t.Errorf("Parse() failed checks = %v, want %v", got.FailedChecks, tt.want)
+			}
+			if got.Valid() {
+				t.Error("Valid() = true with failed checks")
+			}
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+	}{
+		{name: "no lines", lines: nil},
+		{name: "mixed lengths", lines: []string{td3[0], td2[1]}},
+		{name: "TD3 without a passport code", lines: []string{"I" + td3[0][1:], td3[1]}},
+		{name: "TD1 overflow without a check digit", lines: []string{"I<UTOD23145890<<<<<<<<<<<<<<<<", td1[1], td1[2]}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.lines); !errors.Is(err, ErrMalformed) {
+				t.Errorf("Parse() error = %v, want ErrMalformed", err)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   []string
+		format string
+	}{
+		{
+			name:   "back of a card",
+			text:   append([]string{"Đặc điểm nhận dạng: Sẹo", "Ngày, tháng, năm: 01/01/2021"}, td1Citizen...),
+			format: FormatTD1,
+		},
+		{
+			name:   "OCR spacing, case and chevrons",
+			text:   []string{"PASSPORT", "P«UTOERIKSSON««ANNA«MARIA«««««««««««««««««««", strings.ToLower(td3[1][:22]) + " " + td3[1][22:]},
+			format: FormatTD3,
+		},
+		{
+			name:   "zone followed by other text",
+			text:   append(append([]string{}, td2...), "signature"),
+			format: FormatTD2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Find(strings.Join(tt.text, "\n"))
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			if got.Format != tt.format {
+				t.Errorf("Find() format = %s, want %s", got.Format, tt.format)
+			}
+			if !got.Valid() {
+				t.Errorf("Find() failed checks %v", got.FailedChecks)
+			}
+		})
+	}
+
+	if _, err := Find("CĂN CƯỚC CÔNG DÂN\nSố / No.: 001090000001"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Find() without a zone error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPersonalNumber(t *testing.T) {
+	zone, err := Parse(td1Citizen)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := zone.PersonalNumber(); got != "001090000001" {
+		t.Errorf("PersonalNumber() = %q, want %q", got, "001090000001")
+	}
+	if got := zone.FullName(); got != "NGUYEN VAN A" {
+		t.Errorf("FullName() = %q, want %q", got, "NGUYEN VAN A")
+	}
+}
+
+// replace returns a copy of lines with one character changed
+func replace(lines []string, line, index int, c byte) []string {
+	changed := append([]string{}, lines...)
+	b := []byte(changed[line])
+	b[index] = c
+	changed[line] = string(b)
+	return changed
+}
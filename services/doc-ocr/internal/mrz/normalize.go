@@ -0,0 +1,117 @@
+package mrz
+
+import "strings"
+
+// countryCodes maps the special and truncated codes of ICAO 9303 to the
+// ISO 3166-1 alpha-3 code they stand for
+var countryCodes = map[string]string{
+	"D":   "DEU",
+	"GBD": "GBR",
+	"GBN": "GBR",
+	"GBO": "GBR",
+	"GBP": "GBR",
+	"GBS": "GBR",
+	"UNA": "UNO",
+	"UNK": "RKS",
+}
+
+// NormalizeCountry converts an MRZ nationality or issuer code into an
+// ISO 3166-1 alpha-3 code. Letters misread as digits are corrected first.
+func NormalizeCountry(code string) string {
+	code = strings.Trim(alphabetic(code), "<")
+	if normalized, ok := countryCodes[code]; ok {
+		return normalized
+	}
+	return code
+}
+
+// NormalizeSex converts the MRZ sex field into "M", "F" or "X"
+func NormalizeSex(c byte) string {
+	switch c {
+	case 'M', 'F':
+		return string(c)
+	default:
+		return "X"
+	}
+}
+
+// numeric corrects letters that OCR commonly reads in place of digits
+func numeric(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case 'O', 'Q', 'D':
+			return '0'
+		case 'I', 'L':
+			return '1'
+		case 'Z':
+			return '2'
+		case 'S':
+			return '5'
+		case 'G':
+			return '6'
+		case 'B':
+			return '8'
+		}
+		return r
+	}, value)
+}
+
+// alphabetic corrects digits that OCR commonly reads in place of letters
+func alphabetic(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '0':
+			return 'O'
+		case '1':
+			return 'I'
+		case '2':
+			return 'Z'
+		case '5':
+			return 'S'
+		case '6':
+			return 'G'
+		case '8':
+			return 'B'
+		}
+		return r
+	}, value)
+}
+
+// transliterations maps Vietnamese letters to the Latin letters used in MRZ
+var transliterations = map[rune]rune{}
+
+func init() {
+	groups := map[rune]string{
+		'A': "AÀÁẢÃẠĂẰẮẲẴẶÂẦẤẨẪẬaàáảãạăằắẳẵặâầấẩẫậ",
+		'D': "DĐdđ",
+		'E': "EÈÉẺẼẸÊỀẾỂỄỆeèéẻẽẹêềếểễệ",
+		'I': "IÌÍỈĨỊiìíỉĩị",
+		'O': "OÒÓỎÕỌÔỒỐỔỖỘƠỜỚỞỠỢoòóỏõọôồốổỗộơờớởỡợ",
+		'U': "UÙÚỦŨỤƯỪỨỬỮỰuùúủũụưừứửữự",
+		'Y': "YỲÝỶỸỴyỳýỷỹỵ",
+	}
+	for base, letters := range groups {
+		for _, r := range letters {
+			transliterations[r] = base
+		}
+	}
+}
+
+// Transliterate folds a printed name into MRZ form: upper-case Latin letters
+// separated by single spaces
+func Transliterate(name string) string {
+	folded := strings.Map(func(r rune) rune {
+		if base, ok := transliterations[r]; ok {
+			return base
+		}
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z':
+			return r
+		default:
+			return ' '
+		}
+	}, name)
+	return strings.Join(strings.Fields(folded), " ")
+}
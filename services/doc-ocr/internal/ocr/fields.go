@@ -0,0 +1,214 @@
+package ocr
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Canonical names of the fields read from a document
+const (
+	FieldFullName      = "full_name"
+	FieldIDNumber      = "id_number"
+	FieldDateOfBirth   = "dob"
+	FieldSex           = "sex"
+	FieldNationality   = "nationality"
+	FieldPlaceOfOrigin = "place_of_origin"
+	FieldAddress       = "address"
+	FieldIssueDate     = "issue_date"
+	FieldExpiryDate    = "expiry_date"
+	// FieldIDProvince is derived from the identity number, not printed
+	FieldIDProvince = "id_province"
+)
+
+// Canonical names of the fields read from a machine-readable zone
+const (
+	FieldMRZFormat         = "mrz_format"
+	FieldMRZDocumentCode   = "mrz_document_code"
+	FieldMRZIssuer         = "mrz_issuer"
+	FieldMRZNationality    = "mrz_nationality"
+	FieldMRZSex            = "mrz_sex"
+	FieldMRZExpiryDate     = "mrz_expiry_date"
+	FieldMRZValid          = "mrz_valid"
+	FieldMRZDocumentNumber = "mrz_document_number"
+	FieldMRZPersonalNumber = "mrz_personal_number"
+	FieldMRZName           = "mrz_name"
+	FieldMRZDateOfBirth    = "mrz_dob"
+)
+
+// PersonalFields are the fields that identify the holder. They are stored
+// encrypted in person_pii and never copied into results or events.
+var PersonalFields = map[string]bool{
+	FieldFullName:          true,
+	FieldIDNumber:          true,
+	FieldDateOfBirth:       true,
+	FieldPlaceOfOrigin:     true,
+	FieldAddress:           true,
+	FieldIDProvince:        true,
+	FieldMRZDocumentNumber: true,
+	FieldMRZPersonalNumber: true,
+	FieldMRZName:           true,
+	FieldMRZDateOfBirth:    true,
+}
+
+// DateLayout is the layout of every date returned by ParseFields
+const DateLayout = "2006-01-02"
+
+// label maps printed field labels, in Vietnamese and English, to a field
+type label struct {
+	field    string
+	keywords []string
+}
+
+// labels are checked in order; the first label with a matching keyword wins
+var labels = []label{
+	{FieldFullName, []string{"full name", "họ và tên", "họ tên"}},
+	{FieldDateOfBirth, []string{"date of birth", "ngày sinh", "ngày tháng năm sinh"}},
+	{FieldSex, []string{"sex", "giới tính"}},
+	{FieldNationality, []string{"nationality", "quốc tịch"}},
+	{FieldPlaceOfOrigin, []string{"place of origin", "quê quán"}},
+	{FieldAddress, []string{"place of residence", "nơi thường trú", "address", "địa chỉ"}},
+	{FieldExpiryDate, []string{"date of expiry", "có giá trị đến", "giá trị đến"}},
+	{FieldIssueDate, []string{"date of issue", "ngày cấp", "date, month, year", "ngày, tháng, năm"}},
+	{FieldIDNumber, []string{"no.", "số"}},
+}
+
+// multiline fields may wrap onto following lines that carry no label
+var multiline = map[string]bool{
+	FieldAddress:       true,
+	FieldPlaceOfOrigin: true,
+}
+
+// DefaultDateLayouts are the date formats tried when the document type is unknown
+var DefaultDateLayouts = []string{"02/01/2006", "02-01-2006", "02.01.2006", "2006-01-02"}
+
+// ParseFields reads labelled fields from OCR text. A label followed by an
+// empty value takes its value from the next line, and address-like values
+// absorb continuation lines that carry no label. Dates in one of layouts are
+// normalized to DateLayout; other date values are kept as printed so the
+// document profile can report them.
+func ParseFields(text string, layouts []string) map[string]string {
+	fields := make(map[string]string)
+
+	var pending, last string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		field, value, labelled := splitLabel(line)
+		switch {
+		case labelled:
+			pending, last = "", field
+			if value == "" {
+				pending = field
+				continue
+			}
+			set(fields, field, value, layouts)
+		case pending != "":
+			set(fields, pending, line, layouts)
+			pending = ""
+		case strings.Contains(line, ":"):
+			// an unknown label ends any wrapped value
+			last = ""
+		case multiline[last] && fields[last] != "":
+			fields[last] += ", " + line
+		default:
+			last = ""
+		}
+	}
+
+	return fields
+}
+
+// splitLabel splits a "label: value" line and resolves the label to a field
+func splitLabel(line string) (string, string, bool) {
+	idx := strings.Index(line, ":")
+	if idx < 0 {
+		return "", "", false
+	}
+
+	printed := strings.ToLower(line[:idx])
+	for _, l := range labels {
+		for _, keyword := range l.keywords {
+			if hasKeyword(printed, keyword) {
+				return l.field, strings.TrimSpace(line[idx+1:]), true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// hasKeyword reports whether keyword appears in label as whole words
+func hasKeyword(label, keyword string) bool {
+	for start := 0; ; {
+		idx := strings.Index(label[start:], keyword)
+		if idx < 0 {
+			return false
+		}
+		idx += start
+		end := idx + len(keyword)
+		if boundary(label, idx-1) && boundary(label, end) {
+			return true
+		}
+		start = idx + 1
+	}
+}
+
+// boundary reports whether the byte at i is outside the string or not a letter
+func boundary(s string, i int) bool {
+	if i < 0 || i >= len(s) {
+		return true
+	}
+	r := rune(s[i])
+	return r < 0x80 && !unicode.IsLetter(r)
+}
+
+// set normalizes a value for its field and stores it unless empty
+func set(fields map[string]string, field, value string, layouts []string) {
+	switch field {
+	case FieldIDNumber:
+		value = strings.Join(strings.Fields(value), "")
+	case FieldFullName:
+		value = strings.ToUpper(strings.Join(strings.Fields(value), " "))
+	case FieldSex:
+		value = normalizeSex(strings.Join(strings.Fields(value), " "))
+	case FieldDateOfBirth, FieldIssueDate, FieldExpiryDate:
+		value = strings.Join(strings.Fields(value), " ")
+		if date := normalizeDate(value, layouts); date != "" {
+			value = date
+		}
+	default:
+		value = strings.Join(strings.Fields(value), " ")
+	}
+
+	if value != "" {
+		fields[field] = value
+	}
+}
+
+// normalizeSex converts a printed sex into "M" or "F", keeping values it
+// does not recognize
+func normalizeSex(value string) string {
+	lower := strings.ToLower(value)
+	switch {
+	case lower == "f" || strings.HasPrefix(lower, "nữ") || strings.HasPrefix(lower, "nu") || strings.HasPrefix(lower, "female"):
+		return "F"
+	case lower == "m" || strings.HasPrefix(lower, "nam") || strings.HasPrefix(lower, "male"):
+		return "M"
+	default:
+		return value
+	}
+}
+
+// normalizeDate converts a printed date into DateLayout, or returns ""
+func normalizeDate(value string, layouts []string) string {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format(DateLayout)
+		}
+	}
+	return ""
+}
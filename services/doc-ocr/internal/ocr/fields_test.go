@@ -0,0 +1,148 @@
+package ocr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseFields(t *testing.T) {
+	cardLayouts := []string{"02/01/2006"}
+
+	tests := []struct {
+		name    string
+		text    []string
+		layouts []string
+		want    map[string]string
+	}{
+		{
+			name: "bilingual labels",
+			text: []string{
+				"CĂN CƯỚC CÔNG DÂN",
+				"Số / No.: 001 090 000 001",
+				"Họ và tên / Full name: nguyễn  văn a",
+				"Ngày sinh / Date of birth: 01/02/1990",
+				"Giới tính / Sex: Nữ",
+				"Quốc tịch / Nationality: Việt Nam",
+			},
+			layouts: cardLayouts,
+			want: map[string]string{
+				FieldIDNumber:    "001090000001",
+				FieldFullName:    "NGUYỄN VĂN A",
+				FieldDateOfBirth: "1990-02-01",
+				FieldSex:         "F",
+				FieldNationality: "Việt Nam",
+			},
+		},
+		{
+			name: "value on the next line",
+			text: []string{
+				"Họ và tên / Full name:",
+				"Tran Thi B",
+				"Giới tính / Sex: Nam",
+			},
+			layouts: cardLayouts,
+			want: map[string]string{
+				FieldFullName: "TRAN THI B",
+				FieldSex:      "M",
+			},
+		},
+		{
+			name: "wrapped address",
+			text: []string{
+				"Nơi thường trú / Place of residence: 1 Tràng Tiền",
+				"Hoàn Kiếm, Hà Nội",
+				"Có giá trị đến / Date of expiry: 01/01/2030",
+			},
+			layouts: cardLayouts,
+			want: map[string]string{
+				FieldAddress:    "1 Tràng Tiền, Hoàn Kiếm, Hà Nội",
+				FieldExpiryDate: "2030-01-01",
+			},
+		},
+		{
+			name: "unknown label ends a wrapped value",
+			text: []string{
+				"Quê quán / Place of origin: Nam Định",
+				"Đặc điểm nhận dạng: Sẹo",
+				"trên trán",
+			},
+			layouts: cardLayouts,
+			want: map[string]string{
+				FieldPlaceOfOrigin: "Nam Định",
+			},
+		},
+		{
+			name: "date outside the layouts is kept as printed",
+			text: []string{
+				"Ngày sinh / Date of birth: 1990-02-01",
+				"Có giá trị đến / Date of expiry: Không thời hạn",
+			},
+			layouts: cardLayouts,
+			want: map[string]string{
+				FieldDateOfBirth: "1990-02-01",
+				FieldExpiryDate:  "Không thời hạn",
+			},
+		},
+		{
+			name: "default layouts",
+			text: []string{
+				"Ngày cấp / Date of issue: 15.06.2021",
+			},
+			layouts: DefaultDateLayouts,
+			want: map[string]string{
+				FieldIssueDate: "2021-06-15",
+			},
+		},
+		{
+			name: "keywords only match whole words",
+			text: []string{
+				"Sexton: value",
+				"Số / No.: 012345678",
+			},
+			layouts: cardLayouts,
+			want: map[string]string{
+				FieldIDNumber: "012345678",
+			},
+		},
+		{
+			name:    "no labels",
+			text:    []string{"IDVNM0123456789", "random text"},
+			layouts: cardLayouts,
+			want:    map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseFields(strings.Join(tt.text, "\n"), tt.layouts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSex(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"Nam", "M"},
+		{"Nữ", "F"},
+		{"Nu", "F"},
+		{"Male", "M"},
+		{"female", "F"},
+		{"F", "F"},
+		{"M", "M"},
+		{"Khác", "Khác"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := normalizeSex(tt.value); got != tt.want {
+				t.Errorf("normalizeSex(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
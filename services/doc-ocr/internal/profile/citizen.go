@@ -0,0 +1,121 @@
+package profile
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// citizenNumber matches the 12-digit personal identification number printed
+// on CCCD cards and on CMND cards issued since 2012
+var citizenNumber = regexp.MustCompile(`^\d{12}$`)
+
+// provinces maps the first three digits of a personal identification number
+// to the province where the birth was registered
+var provinces = map[string]string{
+	"001": "Hà Nội",
+	"002": "Hà Giang",
+	"004": "Cao Bằng",
+	"006": "Bắc Kạn",
+	"008": "Tuyên Quang",
+	"010": "Lào Cai",
+	"011": "Điện Biên",
+	"012": "Lai Châu",
+	"014": "Sơn La",
+	"015": "Yên Bái",
+	"017": "Hòa Bình",
+	"019": "Thái Nguyên",
+	"020": "Lạng Sơn",
+	"022": "Quảng Ninh",
+	"024": "Bắc Giang",
+	"025": "Phú Thọ",
+	"026": "Vĩnh Phúc",
+	"027": "Bắc Ninh",
+	"030": "Hải Dương",
+	"031": "Hải Phòng",
+	"033": "Hưng Yên",
+	"034": "Thái Bình",
+	"035": "Hà Nam",
+	"036": "Nam Định",
+	"037": "Ninh Bình",
+	"038": "Thanh Hóa",
+	"040": "Nghệ An",
+	"042": "Hà Tĩnh",
+	"044": "Quảng Bình",
+	"045": "Quảng Trị",
+	"046": "Thừa Thiên Huế",
+	"048": "Đà Nẵng",
+	"049": "Quảng Nam",
+	"051": "Quảng Ngãi",
+	"052": "Bình Định",
+	"054": "Phú Yên",
+	"056": "Khánh Hòa",
+	"058": "Ninh Thuận",
+	"060": "Bình Thuận",
+	"062": "Kon Tum",
+	"064": "Gia Lai",
+	"066": "Đắk Lắk",
+	"067": "Đắk Nông",
+	"068": "Lâm Đồng",
+	"070": "Bình Phước",
+	"072": "Tây Ninh",
+	"074": "Bình Dương",
+	"075": "Đồng Nai",
+	"077": "Bà Rịa - Vũng Tàu",
+	"079": "Hồ Chí Minh",
+	"080": "Long An",
+	"082": "Tiền Giang",
+	"083": "Bến Tre",
+	"084": "Trà Vinh",
+	"086": "Vĩnh Long",
+	"087": "Đồng Tháp",
+	"089": "An Giang",
+	"091": "Kiên Giang",
+	"092": "Cần Thơ",
+	"093": "Hậu Giang",
+	"094": "Sóc Trăng",
+	"095": "Bạc Liêu",
+	"096": "Cà Mau",
+}
+
+// Province returns the province encoded in a 12-digit personal
+// identification number
+func Province(idNumber string) (string, bool) {
+	if !citizenNumber.MatchString(idNumber) {
+		return "", false
+	}
+	name, ok := provinces[idNumber[:3]]
+	return name, ok
+}
+
+// citizenBirth decodes the century, sex and year of birth carried by digits
+// four to six of a personal identification number. The fourth digit is
+// 0/1 for men/women born in the 1900s, 2/3 in the 2000s, and so on.
+func citizenBirth(idNumber string) (year int, sex string) {
+	code := int(idNumber[3] - '0')
+	yy, _ := strconv.Atoi(idNumber[4:6])
+
+	sex = "M"
+	if code%2 == 1 {
+		sex = "F"
+	}
+	return 1900 + code/2*100 + yy, sex
+}
+
+// checkCitizenNumber checks the structure of a 12-digit personal
+// identification number against the holder's date of birth and sex, when
+// known. dob uses ocr.DateLayout and sex is "M" or "F".
+func checkCitizenNumber(idNumber, dob, sex string) (province bool, inconsistency string) {
+	_, province = provinces[idNumber[:3]]
+
+	year, encodedSex := citizenBirth(idNumber)
+	if len(dob) >= 4 {
+		if born, err := strconv.Atoi(dob[:4]); err == nil && born != year {
+			return province, fmt.Sprintf("number encodes birth year %d", year)
+		}
+	}
+	if (sex == "M" || sex == "F") && sex != encodedSex {
+		return province, "number encodes a different sex"
+	}
+	return province, ""
+}
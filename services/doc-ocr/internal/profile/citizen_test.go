@@ -0,0 +1,80 @@
+package profile
+
+import "testing"
+
+func TestProvince(t *testing.T) {
+	tests := []struct {
+		idNumber string
+		want     string
+		ok       bool
+	}{
+		{"001090000001", "Hà Nội", true},
+		{"022190000001", "Quảng Ninh", true},
+		{"003090000001", "", false},
+		{"00109000000", "", false},
+		{"001090000001A", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.idNumber, func(t *testing.T) {
+			got, ok := Province(tt.idNumber)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Province(%q) = %q, %v, want %q, %v", tt.idNumber, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestCitizenBirth(t *testing.T) {
+	tests := []struct {
+		idNumber string
+		year     int
+		sex      string
+	}{
+		{"001090000001", 1990, "M"},
+		{"001190000001", 1990, "F"},
+		{"001205000001", 2005, "M"},
+		{"001305000001", 2005, "F"},
+		{"001400000001", 2100, "M"},
+		{"001099000001", 1999, "M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.idNumber, func(t *testing.T) {
+			year, sex := citizenBirth(tt.idNumber)
+			if year != tt.year || sex != tt.sex {
+				t.Errorf("citizenBirth(%q) = %d, %s, want %d, %s", tt.idNumber, year, sex, tt.year, tt.sex)
+			}
+		})
+	}
+}
+
+func TestCheckCitizenNumber(t *testing.T) {
+	tests := []struct {
+		name          string
+		idNumber      string
+		dob           string
+		sex           string
+		province      bool
+		inconsistency bool
+	}{
+		{name: "consistent", idNumber: "001090000001", dob: "1990-01-01", sex: "M", province: true},
+		{name: "woman born in the 2000s", idNumber: "079305000001", dob: "2005-06-30", sex: "F", province: true},
+		{name: "unknown holder", idNumber: "001090000001", province: true},
+		{name: "unknown province", idNumber: "003090000001", dob: "1990-01-01", sex: "M"},
+		{name: "different birth year", idNumber: "001090000001", dob: "1991-01-01", sex: "M", province: true, inconsistency: true},
+		{name: "different century", idNumber: "001290000001", dob: "1990-01-01", province: true, inconsistency: true},
+		{name: "different sex", idNumber: "001090000001", dob: "1990-01-01", sex: "F", province: true, inconsistency: true},
+		{name: "unrecognized sex is not compared", idNumber: "001090000001", sex: "X", province: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			province, inconsistency := checkCitizenNumber(tt.idNumber, tt.dob, tt.sex)
+			if province != tt.province || (inconsistency != "") != tt.inconsistency {
+				t.Errorf("checkCitizenNumber() = %v, %q, want province %v, inconsistency %v", province, inconsistency, tt.province, tt.inconsistency)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package profile
+
+import (
+	"regexp"
+
+	"github.com/ekyc-backend/services/doc-ocr/internal/ocr"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+var (
+	front    = proto.ArtifactType_DOC_FRONT.String()
+	back     = proto.ArtifactType_DOC_BACK.String()
+	passport = proto.ArtifactType_PASSPORT.String()
+)
+
+// cardDateLayouts are the formats printed on, or misread from, Vietnamese ID cards
+var cardDateLayouts = []string{"02/01/2006", "02-01-2006", "02.01.2006"}
+
+// CCCD is the citizen identity card (Căn cước công dân). The chip card
+// carries a TD1 MRZ on its back; holders over 60 get a card without expiry.
+var CCCD = &Profile{
+	Name: "CCCD",
+	Fields: map[string][]string{
+		front: {
+			ocr.FieldIDNumber, ocr.FieldFullName, ocr.FieldDateOfBirth, ocr.FieldSex,
+			ocr.FieldNationality, ocr.FieldPlaceOfOrigin, ocr.FieldAddress, ocr.FieldExpiryDate,
+		},
+		back: {ocr.FieldIssueDate},
+	},
+	IDNumber:       citizenNumber,
+	IDNumberFormat: "12 digits",
+	DateLayouts:    cardDateLayouts,
+	Permanent:      []string{"không thời hạn", "khong thoi han", "indefinite"},
+}
+
+// CMND is the legacy identity card (Chứng minh nhân dân), numbered with 9
+// digits, or 12 digits from 2012. It prints no expiry date and is valid for
+// 15 years from issue.
+var CMND = &Profile{
+	Name: "CMND",
+	Fields: map[string][]string{
+		front: {ocr.FieldIDNumber, ocr.FieldFullName, ocr.FieldDateOfBirth, ocr.FieldPlaceOfOrigin, ocr.FieldAddress},
+		back:  {ocr.FieldIssueDate},
+	},
+	IDNumber:       regexp.MustCompile(`^(\d{9}|\d{12})$`),
+	IDNumberFormat: "9 or 12 digits",
+	DateLayouts:    cardDateLayouts,
+	ValidYears:     15,
+}
+
+// Passport is the Vietnamese passport. Its number is a letter followed by
+// seven digits; the TD3 MRZ carries the holder's personal identification number.
+var Passport = &Profile{
+	Name: "PASSPORT",
+	Fields: map[string][]string{
+		passport: {
+			ocr.FieldFullName, ocr.FieldIDNumber, ocr.FieldDateOfBirth, ocr.FieldSex,
+			ocr.FieldNationality, ocr.FieldExpiryDate,
+		},
+	},
+	IDNumber:       regexp.MustCompile(`^[A-Z]\d{7}$`),
+	IDNumberFormat: "a letter followed by 7 digits",
+	DateLayouts:    []string{"02/01/2006", "02-01-2006", "02 Jan 2006"},
+	MRZRequired:    map[string]bool{passport: true},
+}
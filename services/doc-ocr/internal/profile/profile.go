@@ -0,0 +1,158 @@
+package profile
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/doc-ocr/internal/mrz"
+	"github.com/ekyc-backend/services/doc-ocr/internal/ocr"
+)
+
+// Profile describes how one kind of identity document is read and checked
+type Profile struct {
+	// Name is the document type sent with the upload, such as CCCD
+	Name string
+	// Fields lists the fields expected on each side, keyed by artifact type
+	Fields map[string][]string
+	// IDNumber is the pattern of the document's id_number
+	IDNumber *regexp.Regexp
+	// IDNumberFormat describes IDNumber in reasons
+	IDNumberFormat string
+	// DateLayouts are the printed date formats, normalized to ocr.DateLayout
+	DateLayouts []string
+	// Permanent are printed expiry values meaning the document does not expire
+	Permanent []string
+	// ValidYears is how long the document is valid after issue when it has
+	// no printed expiry date, or 0
+	ValidYears int
+	// MRZRequired lists the sides that must carry a machine-readable zone
+	MRZRequired map[string]bool
+}
+
+// profiles holds every supported document, keyed by name
+var profiles = map[string]*Profile{
+	CCCD.Name:     CCCD,
+	CMND.Name:     CMND,
+	Passport.Name: Passport,
+}
+
+// For returns the profile of a document type, or nil when the type is
+// unknown or was not sent with the upload
+func For(name string) *Profile {
+	return profiles[name]
+}
+
+// mrzCounterparts are the MRZ fields that stand in for a visual field that
+// could not be read
+var mrzCounterparts = map[string]string{
+	ocr.FieldFullName:    ocr.FieldMRZName,
+	ocr.FieldIDNumber:    ocr.FieldMRZDocumentNumber,
+	ocr.FieldDateOfBirth: ocr.FieldMRZDateOfBirth,
+	ocr.FieldSex:         ocr.FieldMRZSex,
+	ocr.FieldNationality: ocr.FieldMRZNationality,
+	ocr.FieldExpiryDate:  ocr.FieldMRZExpiryDate,
+}
+
+// Validate checks the fields read from one side of a document against the
+// profile and returns a reason for every problem found
+func (p *Profile) Validate(side string, fields map[string]string, zone *mrz.MRZ, now time.Time) []reasons.Reason {
+	var found []reasons.Reason
+
+	for _, field := range p.Fields[side] {
+		if fields[field] == "" && fields[mrzCounterparts[field]] == "" {
+			found = append(found, reasons.New(reasons.FieldMissing, field, "expected field could not be read"))
+		}
+	}
+
+	if p.MRZRequired[side] && zone == nil {
+		found = append(found, reasons.New(reasons.MRZMissing, "", "document has no readable machine-readable zone"))
+	}
+
+	if id := fields[ocr.FieldIDNumber]; id != "" {
+		if !p.IDNumber.MatchString(id) {
+			found = append(found, reasons.New(reasons.IDNumberFormat, ocr.FieldIDNumber, "id_number must be "+p.IDNumberFormat))
+		} else if citizenNumber.MatchString(id) {
+			found = append(found, citizenReasons(ocr.FieldIDNumber, id, fields[ocr.FieldDateOfBirth], fields[ocr.FieldSex])...)
+		}
+	}
+
+	// Vietnamese cards and passports carry the holder's personal
+	// identification number in the MRZ optional data
+	if zone != nil {
+		if personal := zone.PersonalNumber(); citizenNumber.MatchString(personal) {
+			found = append(found, citizenReasons(ocr.FieldMRZPersonalNumber, personal, zone.DateOfBirth, zone.Sex)...)
+		}
+	}
+
+	return append(found, p.dateReasons(fields, now)...)
+}
+
+// citizenReasons checks the structure of a 12-digit personal identification number
+func citizenReasons(field, idNumber, dob, sex string) []reasons.Reason {
+	var found []reasons.Reason
+
+	province, inconsistency := checkCitizenNumber(idNumber, dob, sex)
+	if !province {
+		found = append(found, reasons.New(reasons.IDNumberProvince, field, "number does not start with a known province code"))
+	}
+	if inconsistency != "" {
+		found = append(found, reasons.New(reasons.IDNumberInconsistent, field, inconsistency))
+	}
+
+	return found
+}
+
+// dateReasons reports dates that could not be normalized or are implausible,
+// and whether the document has expired
+func (p *Profile) dateReasons(fields map[string]string, now time.Time) []reasons.Reason {
+	var found []reasons.Reason
+
+	dates := make(map[string]time.Time)
+	for _, field := range []string{ocr.FieldDateOfBirth, ocr.FieldIssueDate, ocr.FieldExpiryDate} {
+		value := fields[field]
+		if value == "" || (field == ocr.FieldExpiryDate && p.permanent(value)) {
+			continue
+		}
+		t, err := time.Parse(ocr.DateLayout, value)
+		if err != nil {
+			found = append(found, reasons.New(reasons.DateInvalid, field, "date is not in a supported format"))
+			continue
+		}
+		dates[field] = t
+	}
+
+	dob, hasDOB := dates[ocr.FieldDateOfBirth]
+	issue, hasIssue := dates[ocr.FieldIssueDate]
+	if hasDOB && dob.After(now) {
+		found = append(found, reasons.New(reasons.DateInvalid, ocr.FieldDateOfBirth, "date of birth is in the future"))
+	}
+	if hasIssue && issue.After(now) {
+		found = append(found, reasons.New(reasons.DateInvalid, ocr.FieldIssueDate, "issue date is in the future"))
+	}
+	if hasDOB && hasIssue && issue.Before(dob) {
+		found = append(found, reasons.New(reasons.DateInvalid, ocr.FieldIssueDate, "issue date is before the date of birth"))
+	}
+
+	expiry, hasExpiry := dates[ocr.FieldExpiryDate]
+	if !hasExpiry && hasIssue && p.ValidYears > 0 {
+		expiry, hasExpiry = issue.AddDate(p.ValidYears, 0, 0), true
+	}
+	if hasExpiry && expiry.Before(now) {
+		found = append(found, reasons.New(reasons.DocumentExpired, ocr.FieldExpiryDate, "document expired on "+expiry.Format(ocr.DateLayout)))
+	}
+
+	return found
+}
+
+// permanent reports whether a printed expiry value means no expiry
+func (p *Profile) permanent(value string) bool {
+	value = strings.ToLower(value)
+	for _, marker := range p.Permanent {
+		if strings.Contains(value, marker) {
+			return true
+		}
+	}
+	return false
+}
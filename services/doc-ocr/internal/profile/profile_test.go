@@ -0,0 +1,207 @@
+package profile
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/doc-ocr/internal/mrz"
+	"github.com/ekyc-backend/services/doc-ocr/internal/ocr"
+)
+
+func TestValidate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cccdFront := map[string]string{
+		ocr.FieldIDNumber:      "001090000001",
+		ocr.FieldFullName:      "NGUYỄN VĂN A",
+		ocr.FieldDateOfBirth:   "1990-01-01",
+		ocr.FieldSex:           "M",
+		ocr.FieldNationality:   "Việt Nam",
+		ocr.FieldPlaceOfOrigin: "Hà Nội",
+		ocr.FieldAddress:       "1 Tràng Tiền, Hoàn Kiếm, Hà Nội",
+		ocr.FieldExpiryDate:    "2030-01-01",
+	}
+
+	tests := []struct {
+		name    string
+		profile *Profile
+		side    string
+		fields  map[string]string
+		zone    *mrz.MRZ
+		want    []string
+	}{
+		{
+			name:    "valid CCCD front",
+			profile: CCCD,
+			side:    front,
+			fields:  cccdFront,
+		},
+		{
+			name:    "CCCD front with missing fields",
+			profile: CCCD,
+			side:    front,
+			fields:  with(cccdFront, ocr.FieldAddress, "", ocr.FieldPlaceOfOrigin, ""),
+			want:    []string{reasons.FieldMissing + "/" + ocr.FieldAddress, reasons.FieldMissing + "/" + ocr.FieldPlaceOfOrigin},
+		},
+		{
+			name:    "MRZ stands in for an unread field",
+			profile: CCCD,
+			side:    front,
+			fields:  with(cccdFront, ocr.FieldFullName, "", ocr.FieldMRZName, "NGUYEN VAN A"),
+		},
+		{
+			name:    "CCCD with a 9-digit number",
+			profile: CCCD,
+			side:    front,
+			fields:  with(cccdFront, ocr.FieldIDNumber, "012345678"),
+			want:    []string{reasons.IDNumberFormat + "/" + ocr.FieldIDNumber},
+		},
+		{
+			name:    "CCCD number of another birth year and sex",
+			profile: CCCD,
+			side:    front,
+			fields:  with(cccdFront, ocr.FieldIDNumber, "003191000001"),
+			want:    []string{reasons.IDNumberInconsistent + "/" + ocr.FieldIDNumber, reasons.IDNumberProvince + "/" + ocr.FieldIDNumber},
+		},
+		{
+			name:    "expired CCCD",
+			profile: CCCD,
+			side:    front,
+			fields:  with(cccdFront, ocr.FieldExpiryDate, "2025-12-31"),
+			want:    []string{reasons.DocumentExpired + "/" + ocr.FieldExpiryDate},
+		},
+		{
+			name:    "CCCD without expiry",
+			profile: CCCD,
+			side:    front,
+			fields:  with(cccdFront, ocr.FieldExpiryDate, "Không thời hạn"),
+		},
+		{
+			name:    "CCCD back with an MRZ personal number of another holder",
+			profile: CCCD,
+			side:    back,
+			fields:  map[string]string{ocr.FieldIssueDate: "2021-06-15"},
+			zone:    &mrz.MRZ{DateOfBirth: "1990-01-01", Sex: "F", Optional: []string{"001090000001"}},
+			want:    []string{reasons.IDNumberInconsistent + "/" + ocr.FieldMRZPersonalNumber},
+		},
+		{
+			name:    "unparsed and implausible dates",
+			profile: CCCD,
+			side:    back,
+			fields: map[string]string{
+				ocr.FieldIssueDate:   "2027-01-01",
+				ocr.FieldDateOfBirth: "01/13/1990",
+			},
+			want: []string{reasons.DateInvalid + "/" + ocr.FieldDateOfBirth, reasons.DateInvalid + "/" + ocr.FieldIssueDate},
+		},
+		{
+			name:    "issue date before birth",
+			profile: CCCD,
+			side:    back,
+			fields: map[string]string{
+				ocr.FieldIssueDate:   "1989-01-01",
+				ocr.FieldDateOfBirth: "1990-01-01",
+			},
+			want: []string{reasons.DateInvalid + "/" + ocr.FieldIssueDate},
+		},
+		{
+			name:    "CMND with a 9-digit number",
+			profile: CMND,
+			side:    front,
+			fields: map[string]string{
+				ocr.FieldIDNumber:      "012345678",
+				ocr.FieldFullName:      "TRẦN THỊ B",
+				ocr.FieldDateOfBirth:   "1985-03-04",
+				ocr.FieldPlaceOfOrigin: "Nam Định",
+				ocr.FieldAddress:       "Hà Nội",
+			},
+		},
+		{
+			name:    "CMND expires 15 years after issue",
+			profile: CMND,
+			side:    back,
+			fields:  map[string]string{ocr.FieldIssueDate: "2010-06-15"},
+			want:    []string{reasons.DocumentExpired + "/" + ocr.FieldExpiryDate},
+		},
+		{
+			name:    "CMND within 15 years of issue",
+			profile: CMND,
+			side:    back,
+			fields:  map[string]string{ocr.FieldIssueDate: "2015-06-15"},
+		},
+		{
+			name:    "passport without an MRZ",
+			profile: Passport,
+			side:    passport,
+			fields: map[string]string{
+				ocr.FieldFullName:    "NGUYỄN VĂN A",
+				ocr.FieldIDNumber:    "C1234567",
+				ocr.FieldDateOfBirth: "1990-01-01",
+				ocr.FieldSex:         "M",
+				ocr.FieldNationality: "VIỆT NAM",
+				ocr.FieldExpiryDate:  "2030-01-01",
+			},
+			want: []string{reasons.MRZMissing + "/"},
+		},
+		{
+			name:    "passport number format",
+			profile: Passport,
+			side:    passport,
+			fields: map[string]string{
+				ocr.FieldFullName:    "NGUYỄN VĂN A",
+				ocr.FieldIDNumber:    "12345678",
+				ocr.FieldDateOfBirth: "1990-01-01",
+				ocr.FieldSex:         "M",
+				ocr.FieldNationality: "VIỆT NAM",
+				ocr.FieldExpiryDate:  "2030-01-01",
+			},
+			zone: &mrz.MRZ{DateOfBirth: "1990-01-01", Sex: "M", Optional: []string{"001090000001"}},
+			want: []string{reasons.IDNumberFormat + "/" + ocr.FieldIDNumber},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range tt.profile.Validate(tt.side, tt.fields, tt.zone, now) {
+				got = append(got, r.Code+"/"+r.Field)
+			}
+			sort.Strings(got)
+			sort.Strings(tt.want)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFor(t *testing.T) {
+	for _, name := range []string{"CCCD", "CMND", "PASSPORT"} {
+		if p := For(name); p == nil || p.Name != name {
+			t.Errorf("For(%q) = %v, want the %s profile", name, p, name)
+		}
+	}
+	if p := For(""); p != nil {
+		t.Errorf("For(\"\") = %v, want nil", p)
+	}
+}
+
+// with returns a copy of fields with the given name and value pairs set,
+// removing names set to ""
+func with(fields map[string]string, pairs ...string) map[string]string {
+	copied := make(map[string]string, len(fields))
+	for name, value := range fields {
+		copied[name] = value
+	}
+	for i := 0; i < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			delete(copied, pairs[i])
+			continue
+		}
+		copied[pairs[i]] = pairs[i+1]
+	}
+	return copied
+}
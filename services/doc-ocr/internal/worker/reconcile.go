@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ekyc-backend/pkg/pii"
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/doc-ocr/internal/mrz"
+	"github.com/ekyc-backend/services/doc-ocr/internal/ocr"
+)
+
+// identity holds the fields that are compared across zones and documents.
+// Dates use ocr.DateLayout.
+type identity struct {
+	FullName    string
+	IDNumber    string
+	DateOfBirth string
+	ExpiryDate  string
+}
+
+// visualIdentity returns the fields printed in the visual zone. Dates that
+// could not be normalized are left out.
+func visualIdentity(fields map[string]string) identity {
+	return identity{
+		FullName:    fields[ocr.FieldFullName],
+		IDNumber:    fields[ocr.FieldIDNumber],
+		DateOfBirth: dateField(fields, ocr.FieldDateOfBirth),
+		ExpiryDate:  dateField(fields, ocr.FieldExpiryDate),
+	}
+}
+
+// mrzIdentity returns the fields encoded in a machine-readable zone. ID
+// cards carry the national identity number in their optional data, while the
+// document number is that of the card itself.
+func mrzIdentity(zone *mrz.MRZ) identity {
+	id := identity{
+		FullName:    zone.FullName(),
+		IDNumber:    zone.DocumentNumber,
+		DateOfBirth: zone.DateOfBirth,
+		ExpiryDate:  zone.ExpiryDate,
+	}
+	if personal := zone.PersonalNumber(); personal != "" && zone.DocumentCode != "" && zone.DocumentCode[0] != 'P' {
+		id.IDNumber = personal
+	}
+	return id
+}
+
+// storedIdentity returns the fields already on record for a session
+func storedIdentity(p *pii.Person) identity {
+	id := identity{FullName: p.FullName, IDNumber: p.IDNumber}
+	if p.DateOfBirth != nil {
+		id.DateOfBirth = p.DateOfBirth.Format(ocr.DateLayout)
+	}
+	if p.ExpiryDate != nil {
+		id.ExpiryDate = p.ExpiryDate.Format(ocr.DateLayout)
+	}
+	return id
+}
+
+// mismatches returns a reason for every field set on both sides that
+// disagrees. Names are compared in their transliterated MRZ form, and an ID
+// number matches a document number it ends with.
+func mismatches(a, b identity) []reasons.Reason {
+	var found []reasons.Reason
+
+	mismatch := func(field, label string) {
+		found = append(found, reasons.New(reasons.MRZVisualMismatch, field, "MRZ "+label+" differs from the visual zone"))
+	}
+
+	if a.FullName != "" && b.FullName != "" && compactName(a.FullName) != compactName(b.FullName) {
+		mismatch(ocr.FieldFullName, "name")
+	}
+	if a.IDNumber != "" && b.IDNumber != "" && !sameIDNumber(a.IDNumber, b.IDNumber) {
+		mismatch(ocr.FieldIDNumber, "document number")
+	}
+	if a.DateOfBirth != "" && b.DateOfBirth != "" && a.DateOfBirth != b.DateOfBirth {
+		mismatch(ocr.FieldDateOfBirth, "date of birth")
+	}
+	if a.ExpiryDate != "" && b.ExpiryDate != "" && a.ExpiryDate != b.ExpiryDate {
+		mismatch(ocr.FieldExpiryDate, "expiry date")
+	}
+
+	return found
+}
+
+// checkDigitReasons reports every failed MRZ check digit
+func checkDigitReasons(zone *mrz.MRZ) []reasons.Reason {
+	found := make([]reasons.Reason, 0, len(zone.FailedChecks))
+	for _, field := range zone.FailedChecks {
+		found = append(found, reasons.New(reasons.MRZCheckDigit, field, "MRZ check digit does not match"))
+	}
+	return found
+}
+
+// mrzFields renders a machine-readable zone as extracted fields
+func mrzFields(zone *mrz.MRZ) map[string]string {
+	fields := map[string]string{
+		ocr.FieldMRZFormat:         zone.Format,
+		ocr.FieldMRZDocumentCode:   zone.DocumentCode,
+		ocr.FieldMRZIssuer:         zone.Issuer,
+		ocr.FieldMRZNationality:    zone.Nationality,
+		ocr.FieldMRZSex:            zone.Sex,
+		ocr.FieldMRZExpiryDate:     zone.ExpiryDate,
+		ocr.FieldMRZValid:          strconv.FormatBool(zone.Valid()),
+		ocr.FieldMRZDocumentNumber: zone.DocumentNumber,
+		ocr.FieldMRZPersonalNumber: zone.PersonalNumber(),
+		ocr.FieldMRZName:           zone.FullName(),
+		ocr.FieldMRZDateOfBirth:    zone.DateOfBirth,
+	}
+	for name, value := range fields {
+		if value == "" {
+			delete(fields, name)
+		}
+	}
+	return fields
+}
+
+// dedupe drops repeated reasons for the same code and field
+func dedupe(list []reasons.Reason) []reasons.Reason {
+	seen := make(map[string]bool, len(list))
+	unique := make([]reasons.Reason, 0, len(list))
+	for _, r := range list {
+		key := r.Code + "/" + r.Field
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, r)
+		}
+	}
+	return unique
+}
+
+// compactName folds a name to MRZ letters without spaces, since MRZ
+// filler makes word boundaries unreliable
+func compactName(name string) string {
+	return strings.ReplaceAll(mrz.Transliterate(name), " ", "")
+}
+
+// sameIDNumber compares identity numbers. Long numbers may be truncated to
+// their last nine characters in the MRZ document number field.
+func sameIDNumber(a, b string) bool {
+	a, b = pii.NormalizeIDNumber(a), pii.NormalizeIDNumber(b)
+	if a == b {
+		return true
+	}
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	return len(b) >= 9 && strings.HasSuffix(a, b)
+}
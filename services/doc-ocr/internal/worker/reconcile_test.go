@@ -0,0 +1,174 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/doc-ocr/internal/mrz"
+	"github.com/ekyc-backend/services/doc-ocr/internal/ocr"
+)
+
+func TestMismatches(t *testing.T) {
+	visual := identity{
+		FullName:    "NGUYỄN VĂN A",
+		IDNumber:    "001090000001",
+		DateOfBirth: "1990-01-01",
+		ExpiryDate:  "2030-01-01",
+	}
+
+	tests := []struct {
+		name string
+		zone identity
+		want []string
+	}{
+		{
+			name: "agreeing zones",
+			zone: identity{FullName: "NGUYEN VAN A", IDNumber: "001090000001", DateOfBirth: "1990-01-01", ExpiryDate: "2030-01-01"},
+		},
+		{
+			name: "MRZ filler moves word boundaries",
+			zone: identity{FullName: "NGUYEN VANA"},
+		},
+		{
+			name: "truncated document number",
+			zone: identity{IDNumber: "090000001"},
+		},
+		{
+			name: "missing fields are not compared",
+			zone: identity{},
+		},
+		{
+			name: "different name",
+			zone: identity{FullName: "TRAN VAN A"},
+			want: []string{ocr.FieldFullName},
+		},
+		{
+			name: "different number",
+			zone: identity{IDNumber: "001090000002"},
+			want: []string{ocr.FieldIDNumber},
+		},
+		{
+			name: "different dates",
+			zone: identity{DateOfBirth: "1991-01-01", ExpiryDate: "2031-01-01"},
+			want: []string{ocr.FieldDateOfBirth, ocr.FieldExpiryDate},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range mismatches(tt.zone, visual) {
+				if r.Code != reasons.MRZVisualMismatch {
+					t.Errorf("mismatches() code = %s, want %s", r.Code, reasons.MRZVisualMismatch)
+				}
+				got = append(got, r.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mismatches() fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameIDNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "equal", a: "001090000001", b: "001090000001", want: true},
+		{name: "spacing and case", a: "c 1234567", b: "C1234567", want: true},
+		{name: "last nine characters", a: "001090000001", b: "090000001", want: true},
+		{name: "shorter suffix is not enough", a: "001090000001", b: "90000001"},
+		{name: "different", a: "001090000001", b: "001090000002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameIDNumber(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameIDNumber(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMRZIdentity(t *testing.T) {
+	tests := []struct {
+		name string
+		zone *mrz.MRZ
+		want string
+	}{
+		{
+			name: "ID card uses the personal number",
+			zone: &mrz.MRZ{DocumentCode: "ID", DocumentNumber: "090000001", Optional: []string{"", "001090000001"}},
+			want: "001090000001",
+		},
+		{
+			name: "ID card without a personal number",
+			zone: &mrz.MRZ{DocumentCode: "ID", DocumentNumber: "090000001"},
+			want: "090000001",
+		},
+		{
+			name: "passport uses the document number",
+			zone: &mrz.MRZ{DocumentCode: "P", DocumentNumber: "C1234567", Optional: []string{"001090000001"}},
+			want: "C1234567",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mrzIdentity(tt.zone).IDNumber; got != tt.want {
+				t.Errorf("mrzIdentity().IDNumber = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	visual := identity{FullName: "NGUYỄN VĂN A", IDNumber: "001090000001", DateOfBirth: "1990-01-01"}
+	zone := identity{FullName: "NGUYEN VAN A", IDNumber: "C1234567", ExpiryDate: "2030-01-01"}
+
+	want := identity{FullName: "NGUYỄN VĂN A", IDNumber: "C1234567", DateOfBirth: "1990-01-01", ExpiryDate: "2030-01-01"}
+	if got := overlay(visual, zone); got != want {
+		t.Errorf("overlay() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	list := []reasons.Reason{
+		reasons.New(reasons.MRZCheckDigit, mrz.CheckDateOfBirth, "first"),
+		reasons.New(reasons.MRZVisualMismatch, ocr.FieldFullName, "name"),
+		reasons.New(reasons.MRZCheckDigit, mrz.CheckDateOfBirth, "repeated"),
+		reasons.New(reasons.MRZCheckDigit, mrz.CheckComposite, "other field"),
+	}
+
+	got := dedupe(list)
+	if len(got) != 3 || got[0].Message != "first" || got[2].Field != mrz.CheckComposite {
+		t.Errorf("dedupe() = %+v, want the first of each code and field in order", got)
+	}
+}
+
+func TestDateField(t *testing.T) {
+	fields := map[string]string{
+		ocr.FieldExpiryDate: "2030-01-01",
+		ocr.FieldIssueDate:  "Không thời hạn",
+	}
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{ocr.FieldExpiryDate, "2030-01-01"},
+		{ocr.FieldIssueDate, ""},
+		{ocr.FieldDateOfBirth, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := dateField(fields, tt.field); got != tt.want {
+				t.Errorf("dateField(%s) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
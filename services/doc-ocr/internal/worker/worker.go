@@ -0,0 +1,338 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/pii"
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/doc-ocr/internal/extractor"
+	"github.com/ekyc-backend/services/doc-ocr/internal/mrz"
+	"github.com/ekyc-backend/services/doc-ocr/internal/ocr"
+	"github.com/ekyc-backend/services/doc-ocr/internal/profile"
+	"github.com/ekyc-backend/services/doc-ocr/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// MaxImageSize bounds how much of an object is read, matching the largest
+// document the storage service accepts
+const MaxImageSize = 10 << 20
+
+// documentTypes are the artifact types that carry a document to read
+var documentTypes = map[string]bool{
+	proto.ArtifactType_DOC_FRONT.String(): true,
+	proto.ArtifactType_DOC_BACK.String():  true,
+	proto.ArtifactType_PASSPORT.String():  true,
+}
+
+// Worker runs OCR on uploaded documents. Personal fields go to person_pii,
+// encrypted; the stored result and the ocr.completed event only carry the
+// quality, the non-identifying fields and which fields were found.
+type Worker struct {
+	objects   *storage.EncryptedStore
+	extractor extractor.Extractor
+	results   *repository.ResultRepository
+	people    *pii.Store
+	bus       events.EventBus
+	logger    *logger.Logger
+}
+
+// NewWorker creates a new OCR worker
+func NewWorker(objects *storage.EncryptedStore, extractor extractor.Extractor, results *repository.ResultRepository, people *pii.Store, bus events.EventBus, logger *logger.Logger) *Worker {
+	return &Worker{
+		objects:   objects,
+		extractor: extractor,
+		results:   results,
+		people:    people,
+		bus:       bus,
+		logger:    logger,
+	}
+}
+
+// resultPayload is the shape stored in ekyc_results.payload_json
+type resultPayload struct {
+	ArtifactID   string           `json:"artifact_id"`
+	ArtifactType string           `json:"artifact_type"`
+	DocumentType string           `json:"document_type,omitempty"`
+	Engine       string           `json:"engine"`
+	Fields       []string         `json:"fields"`
+	MRZ          string           `json:"mrz,omitempty"`
+	Reasons      []reasons.Reason `json:"reasons"`
+	OCR          json.RawMessage  `json:"ocr"`
+}
+
+// HandleArtifactUploaded runs OCR on a newly uploaded document. Other
+// artifact types are ignored. The document type sent with the upload selects
+// the profile the fields are validated against; documents uploaded without
+// one are read with default date formats and not validated. Redelivered
+// events reprocess the document and overwrite the earlier result.
+func (w *Worker) HandleArtifactUploaded(ctx context.Context, event *proto.ArtifactUploaded) error {
+	if !documentTypes[event.GetArtifactType()] {
+		return nil
+	}
+
+	log := w.logger.WithContext(ctx).WithSessionID(event.GetSessionId())
+
+	image, err := w.fetch(ctx, event.GetObjectKey())
+	if err != nil {
+		return err
+	}
+
+	extraction, err := w.extractor.Extract(ctx, image)
+	if err != nil {
+		return fmt.Errorf("failed to extract %s: %w", event.GetObjectKey(), err)
+	}
+
+	document := profile.For(event.GetDocumentType())
+	layouts := ocr.DefaultDateLayouts
+	if document != nil {
+		layouts = document.DateLayouts
+	}
+
+	visual := ocr.ParseFields(extraction.Text, layouts)
+	fields := make(map[string]string, len(visual))
+	for name, value := range visual {
+		fields[name] = value
+	}
+	if province, ok := profile.Province(visual[ocr.FieldIDNumber]); ok {
+		fields[ocr.FieldIDProvince] = province
+	}
+
+	// The MRZ is checked on its own and against the visual zone printed
+	// next to it
+	var found []reasons.Reason
+	zone, _ := mrz.Find(extraction.Text)
+	if zone != nil {
+		for name, value := range mrzFields(zone) {
+			fields[name] = value
+		}
+		found = append(found, checkDigitReasons(zone)...)
+		found = append(found, mismatches(mrzIdentity(zone), visualIdentity(visual))...)
+	}
+	if document != nil {
+		found = append(found, document.Validate(event.GetArtifactType(), fields, zone, time.Now().UTC())...)
+	}
+
+	// A card carries its MRZ on the back and its visual zone on the front,
+	// so each side is also checked against what the other side recorded
+	sessionHasMRZ, err := w.results.HasMRZ(ctx, event.GetSessionId(), event.GetArtifactId())
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := w.storePerson(ctx, event.GetSessionId(), visual, zone, zone != nil || sessionHasMRZ)
+	if err != nil {
+		return err
+	}
+	found = dedupe(append(found, conflicts...))
+
+	result, err := w.storeResult(ctx, event, extraction, fields, zone, found)
+	if err != nil {
+		return err
+	}
+
+	err = events.Publish(events.WithSessionID(ctx, event.GetSessionId()), w.bus, &proto.OcrCompleted{
+		ResultId:     result.ID,
+		SessionId:    event.GetSessionId(),
+		ArtifactId:   event.GetArtifactId(),
+		ArtifactType: event.GetArtifactType(),
+		DocumentType: event.GetDocumentType(),
+		Quality:      result.Quality,
+		Fields:       fieldNames(fields),
+		Reasons:      events.ProtoReasons(found),
+		CompletedAt:  timestamppb.New(result.CreatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish %s: %w", events.SubjectOCRCompleted, err)
+	}
+
+	log.Info("Document OCR completed",
+		zap.String("artifact_id", event.GetArtifactId()),
+		zap.String("engine", w.extractor.Name()),
+		zap.Float32("quality", result.Quality),
+		zap.Int("fields", len(fields)),
+		zap.Strings("reasons", reasons.Codes(found)),
+	)
+
+	return nil
+}
+
+// fetch reads a document image, decrypting it if needed
+func (w *Worker) fetch(ctx context.Context, objectKey string) ([]byte, error) {
+	object, err := w.objects.GetFile(ctx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	image, err := io.ReadAll(io.LimitReader(object, MaxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", objectKey, err)
+	}
+	if len(image) > MaxImageSize {
+		return nil, fmt.Errorf("document %s exceeds %d bytes", objectKey, MaxImageSize)
+	}
+
+	return image, nil
+}
+
+// storePerson merges the personal fields read from one document into the
+// session's person_pii record. Fields missing from this document, such as
+// the issue date printed on the back of a card, keep their earlier value.
+// MRZ values with valid check digits take precedence over the visual zone,
+// except for the name, which the MRZ only carries without diacritics. When
+// compare is set, disagreements with the stored record are returned.
+func (w *Worker) storePerson(ctx context.Context, sessionID string, visual map[string]string, zone *mrz.MRZ, compare bool) ([]reasons.Reason, error) {
+	read := visualIdentity(visual)
+	store := read
+	if zone != nil {
+		read = overlay(read, mrzIdentity(zone))
+		if zone.Valid() {
+			store = read
+		}
+	}
+
+	var conflicts []reasons.Reason
+	_, err := w.people.Update(ctx, sessionID, func(p *pii.Person) {
+		if compare {
+			conflicts = mismatches(storedIdentity(p), read)
+		}
+
+		if store.FullName != "" {
+			p.FullName = store.FullName
+		}
+		if store.IDNumber != "" {
+			p.IDNumber = store.IDNumber
+		}
+		if v := visual[ocr.FieldAddress]; v != "" {
+			p.Address = v
+		}
+		if t := parseDate(store.DateOfBirth); t != nil {
+			p.DateOfBirth = t
+		}
+		if t := parseDate(visual[ocr.FieldIssueDate]); t != nil {
+			p.IssueDate = t
+		}
+		if t := parseDate(store.ExpiryDate); t != nil {
+			p.ExpiryDate = t
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+// overlay fills a visual identity from the MRZ, preferring the MRZ for every
+// field but the name
+func overlay(visual, zone identity) identity {
+	merged := zone
+	if visual.FullName != "" {
+		merged.FullName = visual.FullName
+	}
+	if merged.IDNumber == "" {
+		merged.IDNumber = visual.IDNumber
+	}
+	if merged.DateOfBirth == "" {
+		merged.DateOfBirth = visual.DateOfBirth
+	}
+	if merged.ExpiryDate == "" {
+		merged.ExpiryDate = visual.ExpiryDate
+	}
+	return merged
+}
+
+// storeResult saves the OCR result without any personal fields
+func (w *Worker) storeResult(ctx context.Context, event *proto.ArtifactUploaded, extraction *extractor.Extraction, fields map[string]string, zone *mrz.MRZ, found []reasons.Reason) (*repository.Result, error) {
+	extracted := make(map[string]string)
+	for name, value := range fields {
+		if !ocr.PersonalFields[name] {
+			extracted[name] = value
+		}
+	}
+
+	expiry := dateField(fields, ocr.FieldExpiryDate)
+	if zone != nil && zone.Valid() && zone.ExpiryDate != "" {
+		expiry = zone.ExpiryDate
+	}
+
+	ocrJSON, err := protojson.Marshal(&proto.OCRResult{
+		Quality:         extraction.Confidence,
+		IssueDate:       dateField(fields, ocr.FieldIssueDate),
+		ExpiryDate:      expiry,
+		ExtractedFields: extracted,
+		DocumentType:    proto.DocumentType(proto.DocumentType_value["DOCUMENT_TYPE_"+event.GetDocumentType()]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OCR result: %w", err)
+	}
+
+	payload := resultPayload{
+		ArtifactID:   event.GetArtifactId(),
+		ArtifactType: event.GetArtifactType(),
+		DocumentType: event.GetDocumentType(),
+		Engine:       w.extractor.Name(),
+		Fields:       fieldNames(fields),
+		Reasons:      found,
+		OCR:          ocrJSON,
+	}
+	if zone != nil {
+		payload.MRZ = zone.Format
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result payload: %w", err)
+	}
+
+	return w.results.Save(ctx, &repository.Result{
+		SessionID:  event.GetSessionId(),
+		ArtifactID: event.GetArtifactId(),
+		Kind:       repository.KindOCR,
+		Payload:    encoded,
+		Quality:    extraction.Confidence,
+	})
+}
+
+// fieldNames returns the sorted names of the fields found on a document
+func fieldNames(fields map[string]string) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// dateField returns a date field if ocr.ParseFields could normalize it
+func dateField(fields map[string]string, name string) string {
+	if parseDate(fields[name]) == nil {
+		return ""
+	}
+	return fields[name]
+}
+
+// parseDate parses a date normalized by ocr.ParseFields, returning nil for
+// dates kept as printed
+func parseDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(ocr.DateLayout, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/pii"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/doc-ocr/internal/extractor"
+	"github.com/ekyc-backend/services/doc-ocr/internal/repository"
+	"github.com/ekyc-backend/services/doc-ocr/internal/server"
+	"github.com/ekyc-backend/services/doc-ocr/internal/worker"
+	"go.uber.org/zap"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Doc-OCR service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize encryption; PII is never stored in the clear
+	kms, err := crypto.LoadKMS(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to load KMS", zap.Error(err))
+	}
+
+	index, err := crypto.LoadBlindIndex(cfg)
+	if err != nil {
+		log.Fatal("Failed to load blind index key", zap.Error(err))
+	}
+
+	people, err := pii.NewStore(database, kms, index)
+	if err != nil {
+		log.Fatal("Failed to initialize PII store", zap.Error(err))
+	}
+
+	// Initialize object storage
+	minioClient, err := storage.NewMinIO(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to MinIO", zap.Error(err))
+	}
+	objects := storage.NewEncryptedStore(minioClient, kms, storage.NewDataKeys(database), log)
+
+	// Initialize the OCR engine
+	engine, err := extractor.New(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize OCR engine", zap.Error(err))
+	}
+	log.Info("OCR engine ready", zap.String("engine", engine.Name()))
+
+	// Initialize event bus
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// Run OCR on every verified document upload
+	ocrWorker := worker.NewWorker(objects, engine, repository.NewResultRepository(database), people, bus, log)
+	retry := events.WithRetryPolicy(events.RetryPolicy{
+		MaxAttempts:  cfg.OCRMaxAttempts,
+		InitialDelay: cfg.OCRRetryDelay,
+		MaxDelay:     cfg.OCRMaxRetryDelay,
+	})
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, ocrWorker.HandleArtifactUploaded, retry); err != nil {
+		log.Fatal("Failed to subscribe to artifact uploads", zap.Error(err))
+	}
+
+	// Initialize HTTP server for health checks
+	httpServer := server.NewHTTPServer(cfg)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}
@@ -0,0 +1,83 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ekyc-backend/pkg/config"
+)
+
+// Embedder names accepted in FACE_EMBEDDER
+const (
+	EmbedderONNX = "onnx"
+	EmbedderFake = "fake"
+)
+
+// ErrNoFace is returned when an image contains no detectable face
+var ErrNoFace = errors.New("no face detected")
+
+// Embedding is the feature vector of the most prominent face in an image
+type Embedding struct {
+	Vector []float32
+	// Confidence is the face detection confidence in [0, 1]
+	Confidence float32
+}
+
+// FaceEmbedder turns a face image into an embedding that can be compared by
+// cosine similarity
+type FaceEmbedder interface {
+	// Name identifies the embedder in stored results
+	Name() string
+	// Embed detects the most prominent face in an encoded image and embeds it
+	Embed(ctx context.Context, image []byte) (*Embedding, error)
+	// Close releases the resources held by the embedder
+	Close() error
+}
+
+// New builds the embedder selected by the configuration
+func New(cfg *config.Config) (FaceEmbedder, error) {
+	switch cfg.FaceEmbedder {
+	case EmbedderONNX:
+		return NewONNX(cfg.ONNXRuntimeLibrary, cfg.FaceDetectorModelPath, cfg.FaceModelPath)
+	case EmbedderFake:
+		return NewFake(), nil
+	default:
+		return nil, fmt.Errorf("unsupported face embedder %q", cfg.FaceEmbedder)
+	}
+}
+
+// Similarity returns the cosine similarity of two embeddings in [-1, 1]
+func Similarity(a, b []float32) float32 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return float32(dot / math.Sqrt(normA*normB))
+}
+
+// normalize scales a vector to unit length in place
+func normalize(vector []float32) {
+	var sum float64
+	for _, v := range vector {
+		sum += float64(v) * float64(v)
+	}
+	if sum == 0 {
+		return
+	}
+	norm := float32(math.Sqrt(sum))
+	for i := range vector {
+		vector[i] /= norm
+	}
+}
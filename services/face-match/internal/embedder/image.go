@@ -0,0 +1,99 @@
+package embedder
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// decode reads a JPEG or PNG image
+func decode(data []byte) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, nil
+}
+
+// planar resamples area of img to width x height with bilinear filtering and
+// returns it as a CHW RGB tensor, each channel value mapped to (v - mean) / std
+func planar(img image.Image, area image.Rectangle, width, height int, mean, std float32) []float32 {
+	plane := width * height
+	data := make([]float32, 3*plane)
+
+	scaleX := float32(area.Dx()) / float32(width)
+	scaleY := float32(area.Dy()) / float32(height)
+
+	for y := 0; y < height; y++ {
+		sy := float32(area.Min.Y) + (float32(y)+0.5)*scaleY - 0.5
+		for x := 0; x < width; x++ {
+			sx := float32(area.Min.X) + (float32(x)+0.5)*scaleX - 0.5
+			r, g, b := bilinear(img, sx, sy)
+			i := y*width + x
+			data[i] = (r - mean) / std
+			data[plane+i] = (g - mean) / std
+			data[2*plane+i] = (b - mean) / std
+		}
+	}
+
+	return data
+}
+
+// bilinear samples img at a fractional position, clamping to its bounds.
+// Channels are returned in [0, 255].
+func bilinear(img image.Image, x, y float32) (float32, float32, float32) {
+	bounds := img.Bounds()
+	x0, y0 := int(floor(x)), int(floor(y))
+	fx, fy := x-float32(x0), y-float32(y0)
+
+	var rgb [3]float32
+	for _, corner := range [4]struct {
+		dx, dy int
+		weight float32
+	}{
+		{0, 0, (1 - fx) * (1 - fy)},
+		{1, 0, fx * (1 - fy)},
+		{0, 1, (1 - fx) * fy},
+		{1, 1, fx * fy},
+	} {
+		px := clamp(x0+corner.dx, bounds.Min.X, bounds.Max.X-1)
+		py := clamp(y0+corner.dy, bounds.Min.Y, bounds.Max.Y-1)
+		r, g, b, _ := img.At(px, py).RGBA()
+		rgb[0] += corner.weight * float32(r>>8)
+		rgb[1] += corner.weight * float32(g>>8)
+		rgb[2] += corner.weight * float32(b>>8)
+	}
+
+	return rgb[0], rgb[1], rgb[2]
+}
+
+// squareAround returns the square of side max(w, h) * scale centred on box,
+// clipped to bounds
+func squareAround(box image.Rectangle, scale float32, bounds image.Rectangle) image.Rectangle {
+	side := box.Dx()
+	if box.Dy() > side {
+		side = box.Dy()
+	}
+	half := int(float32(side)*scale) / 2
+	cx, cy := (box.Min.X+box.Max.X)/2, (box.Min.Y+box.Max.Y)/2
+	return image.Rect(cx-half, cy-half, cx+half, cy+half).Intersect(bounds)
+}
+
+func floor(v float32) float32 {
+	if v < 0 && v != float32(int(v)) {
+		return float32(int(v) - 1)
+	}
+	return float32(int(v))
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
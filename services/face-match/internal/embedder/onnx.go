@@ -0,0 +1,203 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"strings"
+
+	ort "github.com/yalue/onnxruntime_go"
+)
+
+const (
+	// MinFaceScore is the lowest detector score accepted as a face
+	MinFaceScore = 0.7
+	// faceMargin widens the detected box so the crop includes the whole face
+	faceMargin = 1.1
+)
+
+// ONNX embeds faces on the CPU with ONNX Runtime. A detector in the UltraFace
+// layout (scores and normalized corner boxes per anchor) finds the most
+// confident face, which is cropped and embedded by a recognition model such
+// as ArcFace. Model input sizes are read from the models themselves.
+type ONNX struct {
+	detector    *ort.DynamicAdvancedSession
+	detectorIn  ort.Shape
+	scoresShape ort.Shape
+	boxesShape  ort.Shape
+
+	recognizer     *ort.DynamicAdvancedSession
+	recognizerIn   ort.Shape
+	embeddingShape ort.Shape
+}
+
+// NewONNX loads ONNX Runtime from library and the detector and recognition models
+func NewONNX(library, detectorPath, recognizerPath string) (*ONNX, error) {
+	ort.SetSharedLibraryPath(library)
+	if err := ort.InitializeEnvironment(); err != nil {
+		return nil, fmt.Errorf("failed to initialize ONNX Runtime: %w", err)
+	}
+
+	e := &ONNX{}
+
+	inputs, outputs, err := ort.GetInputOutputInfo(detectorPath)
+	if err != nil {
+		e.Close()
+		return nil, fmt.Errorf("failed to inspect face detector: %w", err)
+	}
+	if len(inputs) != 1 || len(inputs[0].Dimensions) != 4 || len(outputs) != 2 {
+		e.Close()
+		return nil, fmt.Errorf("face detector must have one NCHW input and two outputs")
+	}
+	scores, boxes := detectorOutputs(outputs)
+	e.detectorIn = fixed(inputs[0].Dimensions)
+	e.scoresShape, e.boxesShape = fixed(scores.Dimensions), fixed(boxes.Dimensions)
+	e.detector, err = ort.NewDynamicAdvancedSession(detectorPath, []string{inputs[0].Name}, []string{scores.Name, boxes.Name}, nil)
+	if err != nil {
+		e.Close()
+		return nil, fmt.Errorf("failed to load face detector: %w", err)
+	}
+
+	inputs, outputs, err = ort.GetInputOutputInfo(recognizerPath)
+	if err != nil {
+		e.Close()
+		return nil, fmt.Errorf("failed to inspect face model: %w", err)
+	}
+	if len(inputs) != 1 || len(inputs[0].Dimensions) != 4 || len(outputs) != 1 {
+		e.Close()
+		return nil, fmt.Errorf("face model must have one NCHW input and one output")
+	}
+	e.recognizerIn, e.embeddingShape = fixed(inputs[0].Dimensions), fixed(outputs[0].Dimensions)
+	e.recognizer, err = ort.NewDynamicAdvancedSession(recognizerPath, []string{inputs[0].Name}, []string{outputs[0].Name}, nil)
+	if err != nil {
+		e.Close()
+		return nil, fmt.Errorf("failed to load face model: %w", err)
+	}
+
+	return e, nil
+}
+
+// Name returns the embedder name
+func (e *ONNX) Name() string {
+	return EmbedderONNX
+}
+
+// Embed detects the most confident face in an image and embeds it
+func (e *ONNX) Embed(ctx context.Context, data []byte) (*Embedding, error) {
+	img, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	box, score, err := e.detect(img)
+	if err != nil {
+		return nil, err
+	}
+
+	face := squareAround(box, faceMargin, img.Bounds())
+	if face.Empty() {
+		return nil, ErrNoFace
+	}
+
+	height, width := int(e.recognizerIn[2]), int(e.recognizerIn[3])
+	input, err := ort.NewTensor(e.recognizerIn, planar(img, face, width, height, 127.5, 127.5))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create face tensor: %w", err)
+	}
+	defer input.Destroy()
+
+	output, err := ort.NewEmptyTensor[float32](e.embeddingShape)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedding tensor: %w", err)
+	}
+	defer output.Destroy()
+
+	if err := e.recognizer.Run([]ort.ArbitraryTensor{input}, []ort.ArbitraryTensor{output}); err != nil {
+		return nil, fmt.Errorf("failed to run face model: %w", err)
+	}
+
+	vector := append([]float32(nil), output.GetData()...)
+	normalize(vector)
+
+	return &Embedding{Vector: vector, Confidence: score}, nil
+}
+
+// detect returns the box and score of the most confident face
+func (e *ONNX) detect(img image.Image) (image.Rectangle, float32, error) {
+	height, width := int(e.detectorIn[2]), int(e.detectorIn[3])
+	input, err := ort.NewTensor(e.detectorIn, planar(img, img.Bounds(), width, height, 127, 128))
+	if err != nil {
+		return image.Rectangle{}, 0, fmt.Errorf("failed to create detector tensor: %w", err)
+	}
+	defer input.Destroy()
+
+	scores, err := ort.NewEmptyTensor[float32](e.scoresShape)
+	if err != nil {
+		return image.Rectangle{}, 0, fmt.Errorf("failed to create scores tensor: %w", err)
+	}
+	defer scores.Destroy()
+
+	boxes, err := ort.NewEmptyTensor[float32](e.boxesShape)
+	if err != nil {
+		return image.Rectangle{}, 0, fmt.Errorf("failed to create boxes tensor: %w", err)
+	}
+	defer boxes.Destroy()
+
+	if err := e.detector.Run([]ort.ArbitraryTensor{input}, []ort.ArbitraryTensor{scores, boxes}); err != nil {
+		return image.Rectangle{}, 0, fmt.Errorf("failed to run face detector: %w", err)
+	}
+
+	// Scores hold a background and a face probability per anchor
+	best, bestScore := -1, float32(0)
+	s := scores.GetData()
+	for i := 0; 2*i+1 < len(s); i++ {
+		if s[2*i+1] > bestScore {
+			best, bestScore = i, s[2*i+1]
+		}
+	}
+	b := boxes.GetData()
+	if best < 0 || bestScore < MinFaceScore || 4*best+3 >= len(b) {
+		return image.Rectangle{}, 0, ErrNoFace
+	}
+
+	bounds := img.Bounds()
+	w, h := float32(bounds.Dx()), float32(bounds.Dy())
+	box := image.Rect(
+		bounds.Min.X+int(b[4*best]*w), bounds.Min.Y+int(b[4*best+1]*h),
+		bounds.Min.X+int(b[4*best+2]*w), bounds.Min.Y+int(b[4*best+3]*h),
+	)
+
+	return box, bestScore, nil
+}
+
+// Close releases the models and the ONNX Runtime environment
+func (e *ONNX) Close() error {
+	if e.detector != nil {
+		e.detector.Destroy()
+	}
+	if e.recognizer != nil {
+		e.recognizer.Destroy()
+	}
+	return ort.DestroyEnvironment()
+}
+
+// detectorOutputs picks the scores and boxes outputs by name, falling back
+// to their declared order
+func detectorOutputs(outputs []ort.InputOutputInfo) (ort.InputOutputInfo, ort.InputOutputInfo) {
+	if strings.Contains(strings.ToLower(outputs[0].Name), "box") {
+		return outputs[1], outputs[0]
+	}
+	return outputs[0], outputs[1]
+}
+
+// fixed replaces dynamic dimensions, such as the batch size, with 1
+func fixed(dims ort.Shape) ort.Shape {
+	shape := make(ort.Shape, len(dims))
+	for i, d := range dims {
+		if d <= 0 {
+			d = 1
+		}
+		shape[i] = d
+	}
+	return shape
+}
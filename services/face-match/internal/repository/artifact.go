@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// Artifact is a verified upload of a session
+type Artifact struct {
+	ID        string
+	SessionID string
+	Type      string
+	Key       string
+	// DocumentType is the short document type name of identity documents
+	DocumentType string
+}
+
+// ArtifactRepository reads the artifacts recorded by the storage service
+type ArtifactRepository struct {
+	db *db.DB
+}
+
+// NewArtifactRepository creates a new artifact repository
+func NewArtifactRepository(database *db.DB) *ArtifactRepository {
+	return &ArtifactRepository{db: database}
+}
+
+// Latest returns the most recent artifact of a session with one of the given
+// types, or apperrors.ErrRecordNotFound
+func (r *ArtifactRepository) Latest(ctx context.Context, sessionID string, types ...string) (*Artifact, error) {
+	a := &Artifact{SessionID: sessionID}
+
+	err := r.db.QueryRow(ctx, `
+		SELECT id::text, type::text, s3_key, COALESCE(meta_json->>'document_type', '')
+		FROM ekyc_artifacts
+		WHERE session_id = $1 AND type::text = ANY($2)
+		ORDER BY created_at DESC
+		LIMIT 1`,
+		sessionID, types,
+	).Scan(&a.ID, &a.Type, &a.Key, &a.DocumentType)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to look up artifact: %w", err)
+	}
+
+	return a, nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+)
+
+// KindFace is the result_kind of face match results
+const KindFace = "FACE"
+
+// Result is a stored processing result for one artifact
+type Result struct {
+	ID         string
+	SessionID  string
+	ArtifactID string
+	Kind       string
+	Payload    []byte
+	Quality    float32
+	CreatedAt  time.Time
+}
+
+// ResultRepository persists results in ekyc_results
+type ResultRepository struct {
+	db *db.DB
+}
+
+// NewResultRepository creates a new result repository
+func NewResultRepository(database *db.DB) *ResultRepository {
+	return &ResultRepository{db: database}
+}
+
+// Save stores the result of an artifact, replacing an earlier result of the
+// same kind so reprocessing a redelivered upload stays idempotent
+func (r *ResultRepository) Save(ctx context.Context, result *Result) (*Result, error) {
+	saved := *result
+
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO ekyc_results (session_id, artifact_id, kind, payload_json, quality)
+		VALUES ($1, $2, $3::result_kind, $4, $5)
+		ON CONFLICT (artifact_id, kind) DO UPDATE
+		SET payload_json = EXCLUDED.payload_json, quality = EXCLUDED.quality, created_at = NOW()
+		RETURNING id::text, created_at`,
+		result.SessionID, result.ArtifactID, result.Kind, result.Payload, result.Quality,
+	).Scan(&saved.ID, &saved.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save %s result: %w", result.Kind, err)
+	}
+
+	return &saved, nil
+}
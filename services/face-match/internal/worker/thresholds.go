@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Thresholds holds the minimum cosine similarity for a face match. Photos
+// printed on older documents match less closely, so each document type can
+// have its own threshold.
+type Thresholds struct {
+	Default    float32
+	ByDocument map[string]float32
+}
+
+// ParseThresholds reads per-document thresholds written as
+// "CCCD=0.40,CMND=0.35", falling back to def for other documents
+func ParseThresholds(def float64, spec string) (Thresholds, error) {
+	t := Thresholds{Default: float32(def), ByDocument: make(map[string]float32)}
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return Thresholds{}, fmt.Errorf("invalid face match threshold %q", entry)
+		}
+		threshold, err := strconv.ParseFloat(strings.TrimSpace(value), 32)
+		if err != nil || threshold < -1 || threshold > 1 {
+			return Thresholds{}, fmt.Errorf("invalid face match threshold %q", entry)
+		}
+		t.ByDocument[strings.ToUpper(strings.TrimSpace(name))] = float32(threshold)
+	}
+
+	return t, nil
+}
+
+// For returns the threshold of a document type
+func (t Thresholds) For(documentType string) float32 {
+	if threshold, ok := t.ByDocument[documentType]; ok {
+		return threshold
+	}
+	return t.Default
+}
@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseThresholds(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    map[string]float32
+		wantErr bool
+	}{
+		{name: "empty", spec: "", want: map[string]float32{}},
+		{name: "per document", spec: "CCCD=0.40,CMND=0.35,PASSPORT=0.45", want: map[string]float32{"CCCD": 0.40, "CMND": 0.35, "PASSPORT": 0.45}},
+		{name: "spacing and case", spec: " cccd = 0.5 , ,CMND=0.3", want: map[string]float32{"CCCD": 0.5, "CMND": 0.3}},
+		{name: "missing value", spec: "CCCD", wantErr: true},
+		{name: "not a number", spec: "CCCD=high", wantErr: true},
+		{name: "out of range", spec: "CCCD=1.5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseThresholds(0.4, tt.spec)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ParseThresholds(%q) error = %v, want error %v", tt.spec, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Default != 0.4 {
+				t.Errorf("ParseThresholds(%q) default = %v, want 0.4", tt.spec, got.Default)
+			}
+			if !reflect.DeepEqual(got.ByDocument, tt.want) {
+				t.Errorf("ParseThresholds(%q) = %v, want %v", tt.spec, got.ByDocument, tt.want)
+			}
+		})
+	}
+}
+
+func TestThresholdsFor(t *testing.T) {
+	thresholds := Thresholds{Default: 0.4, ByDocument: map[string]float32{"CMND": 0.35}}
+
+	tests := []struct {
+		documentType string
+		want         float32
+	}{
+		{"CMND", 0.35},
+		{"CCCD", 0.4},
+		{"", 0.4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.documentType, func(t *testing.T) {
+			if got := thresholds.For(tt.documentType); got != tt.want {
+				t.Errorf("For(%q) = %v, want %v", tt.documentType, got, tt.want)
+			}
+		})
+	}
+}
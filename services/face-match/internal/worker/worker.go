@@ -0,0 +1,245 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/face-match/internal/embedder"
+	"github.com/ekyc-backend/services/face-match/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// MaxImageSize bounds how much of an object is read, matching the largest
+// document the storage service accepts
+const MaxImageSize = 10 << 20
+
+var (
+	// documentTypes are the artifact types that carry the holder's photo
+	documentTypes = []string{proto.ArtifactType_DOC_FRONT.String(), proto.ArtifactType_PASSPORT.String()}
+	selfieType    = proto.ArtifactType_SELFIE.String()
+)
+
+// Worker compares the photo on a session's identity document with its
+// selfie. Embeddings only live in memory; the stored result and the
+// face.completed event carry the similarity and the decision.
+type Worker struct {
+	objects    *storage.EncryptedStore
+	embedder   embedder.FaceEmbedder
+	thresholds Thresholds
+	artifacts  *repository.ArtifactRepository
+	results    *repository.ResultRepository
+	bus        events.EventBus
+	logger     *logger.Logger
+}
+
+// NewWorker creates a new face match worker
+func NewWorker(objects *storage.EncryptedStore, faces embedder.FaceEmbedder, thresholds Thresholds, artifacts *repository.ArtifactRepository, results *repository.ResultRepository, bus events.EventBus, logger *logger.Logger) *Worker {
+	return &Worker{
+		objects:    objects,
+		embedder:   faces,
+		thresholds: thresholds,
+		artifacts:  artifacts,
+		results:    results,
+		bus:        bus,
+		logger:     logger,
+	}
+}
+
+// resultPayload is the shape stored in ekyc_results.payload_json
+type resultPayload struct {
+	DocumentArtifactID string           `json:"document_artifact_id"`
+	SelfieArtifactID   string           `json:"selfie_artifact_id"`
+	DocumentType       string           `json:"document_type,omitempty"`
+	Embedder           string           `json:"embedder"`
+	Reasons            []reasons.Reason `json:"reasons"`
+	Face               json.RawMessage  `json:"face"`
+}
+
+// HandleArtifactUploaded matches a session's latest document photo against
+// its latest selfie once both have been uploaded. It runs on either upload,
+// so whichever arrives second triggers the match; a newer upload of either
+// side replaces the result. The result is stored against the selfie.
+func (w *Worker) HandleArtifactUploaded(ctx context.Context, event *proto.ArtifactUploaded) error {
+	if event.GetArtifactType() != selfieType && !isDocument(event.GetArtifactType()) {
+		return nil
+	}
+
+	log := w.logger.WithContext(ctx).WithSessionID(event.GetSessionId())
+
+	document, err := w.artifacts.Latest(ctx, event.GetSessionId(), documentTypes...)
+	if errors.Is(err, apperrors.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	selfie, err := w.artifacts.Latest(ctx, event.GetSessionId(), selfieType)
+	if errors.Is(err, apperrors.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	match, found, err := w.match(ctx, document, selfie)
+	if err != nil {
+		return err
+	}
+
+	result, err := w.storeResult(ctx, document, selfie, match, found)
+	if err != nil {
+		return err
+	}
+
+	err = events.Publish(events.WithSessionID(ctx, event.GetSessionId()), w.bus, &proto.FaceMatched{
+		ResultId:           result.ID,
+		SessionId:          event.GetSessionId(),
+		DocumentArtifactId: document.ID,
+		SelfieArtifactId:   selfie.ID,
+		DocumentType:       document.DocumentType,
+		Similarity:         match.Similarity,
+		Threshold:          match.Threshold,
+		Passed:             match.Passed,
+		Confidence:         match.Confidence,
+		Reasons:            events.ProtoReasons(found),
+		CompletedAt:        timestamppb.New(result.CreatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish %s: %w", events.SubjectFaceCompleted, err)
+	}
+
+	log.Info("Face match completed",
+		zap.String("document_artifact_id", document.ID),
+		zap.String("selfie_artifact_id", selfie.ID),
+		zap.String("embedder", w.embedder.Name()),
+		zap.Float32("similarity", match.Similarity),
+		zap.Float32("threshold", match.Threshold),
+		zap.Bool("passed", match.Passed),
+		zap.Strings("reasons", reasons.Codes(found)),
+	)
+
+	return nil
+}
+
+// photo is a fetched image of one artifact
+type photo struct {
+	artifactType string
+	image        []byte
+}
+
+// match fetches the document photo and the selfie and compares them
+func (w *Worker) match(ctx context.Context, document, selfie *repository.Artifact) (*proto.FaceMatchResult, []reasons.Reason, error) {
+	photos := make([]photo, 0, 2)
+	for _, artifact := range []*repository.Artifact{document, selfie} {
+		image, err := w.fetch(ctx, artifact.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		photos = append(photos, photo{artifactType: artifact.Type, image: image})
+	}
+
+	return w.compare(ctx, document.DocumentType, photos[0], photos[1])
+}
+
+// compare embeds both photos and compares them against the threshold of the
+// document type. An image without a face fails the match with a reason
+// rather than an error, since retrying cannot change the outcome.
+func (w *Worker) compare(ctx context.Context, documentType string, document, selfie photo) (*proto.FaceMatchResult, []reasons.Reason, error) {
+	match := &proto.FaceMatchResult{Threshold: w.thresholds.For(documentType)}
+
+	var found []reasons.Reason
+	embeddings := make([]*embedder.Embedding, 0, 2)
+	for _, p := range []photo{document, selfie} {
+		embedding, err := w.embedder.Embed(ctx, p.image)
+		if errors.Is(err, embedder.ErrNoFace) {
+			found = append(found, reasons.New(reasons.FaceNotDetected, p.artifactType, "no face found on "+p.artifactType))
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to embed %s: %w", p.artifactType, err)
+		}
+		embeddings = append(embeddings, embedding)
+	}
+	if len(found) > 0 {
+		return match, found, nil
+	}
+
+	match.Similarity = embedder.Similarity(embeddings[0].Vector, embeddings[1].Vector)
+	match.Confidence = min(embeddings[0].Confidence, embeddings[1].Confidence)
+	match.Passed = match.Similarity >= match.Threshold
+	if !match.Passed {
+		found = append(found, reasons.New(reasons.FaceMismatch, "", "selfie does not match the document photo"))
+	}
+
+	return match, found, nil
+}
+
+// fetch reads an image, decrypting it if needed
+func (w *Worker) fetch(ctx context.Context, objectKey string) ([]byte, error) {
+	object, err := w.objects.GetFile(ctx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	image, err := io.ReadAll(io.LimitReader(object, MaxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", objectKey, err)
+	}
+	if len(image) > MaxImageSize {
+		return nil, fmt.Errorf("image %s exceeds %d bytes", objectKey, MaxImageSize)
+	}
+
+	return image, nil
+}
+
+// storeResult saves the match against the selfie artifact
+func (w *Worker) storeResult(ctx context.Context, document, selfie *repository.Artifact, match *proto.FaceMatchResult, found []reasons.Reason) (*repository.Result, error) {
+	faceJSON, err := protojson.Marshal(match)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal face match result: %w", err)
+	}
+
+	encoded, err := json.Marshal(resultPayload{
+		DocumentArtifactID: document.ID,
+		SelfieArtifactID:   selfie.ID,
+		DocumentType:       document.DocumentType,
+		Embedder:           w.embedder.Name(),
+		Reasons:            found,
+		Face:               faceJSON,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result payload: %w", err)
+	}
+
+	return w.results.Save(ctx, &repository.Result{
+		SessionID:  selfie.SessionID,
+		ArtifactID: selfie.ID,
+		Kind:       repository.KindFace,
+		Payload:    encoded,
+		Quality:    match.Confidence,
+	})
+}
+
+// isDocument reports whether an artifact type carries the holder's photo
+func isDocument(artifactType string) bool {
+	for _, t := range documentTypes {
+		if t == artifactType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/face-match/internal/embedder"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func TestCompare(t *testing.T) {
+	faces := embedder.NewFake()
+	faces.Enroll([]byte("card photo of A"), "A")
+	faces.Enroll([]byte("selfie of A"), "A")
+
+	w := &Worker{
+		embedder:   faces,
+		thresholds: Thresholds{Default: 0.4, ByDocument: map[string]float32{"CMND": 0.35, "LENIENT": -1}},
+	}
+
+	document := proto.ArtifactType_DOC_FRONT.String()
+	selfie := proto.ArtifactType_SELFIE.String()
+
+	tests := []struct {
+		name          string
+		documentType  string
+		documentImage string
+		selfieImage   string
+		threshold     float32
+		passed        bool
+		reasons       []string
+	}{
+		{
+			name:          "same image",
+			documentType:  "CCCD",
+			documentImage: "photo",
+			selfieImage:   "photo",
+			threshold:     0.4,
+			passed:        true,
+		},
+		{
+			name:          "same subject",
+			documentType:  "CMND",
+			documentImage: "card photo of A",
+			selfieImage:   "selfie of A",
+			threshold:     0.35,
+			passed:        true,
+		},
+		{
+			name:          "different subjects",
+			documentType:  "CCCD",
+			documentImage: "card photo of A",
+			selfieImage:   "selfie of B",
+			threshold:     0.4,
+			reasons:       []string{reasons.FaceMismatch + "/"},
+		},
+		{
+			name:          "threshold of the document type",
+			documentType:  "LENIENT",
+			documentImage: "card photo of A",
+			selfieImage:   "selfie of B",
+			threshold:     -1,
+			passed:        true,
+		},
+		{
+			name:          "no face on the selfie",
+			documentType:  "CCCD",
+			documentImage: "card photo of A",
+			selfieImage:   "",
+			threshold:     0.4,
+			reasons:       []string{reasons.FaceNotDetected + "/" + selfie},
+		},
+		{
+			name:         "no face on either photo",
+			documentType: "CCCD",
+			threshold:    0.4,
+			reasons:      []string{reasons.FaceNotDetected + "/" + document, reasons.FaceNotDetected + "/" + selfie},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, found, err := w.compare(context.Background(), tt.documentType,
+				photo{artifactType: document, image: []byte(tt.documentImage)},
+				photo{artifactType: selfie, image: []byte(tt.selfieImage)},
+			)
+			if err != nil {
+				t.Fatalf("compare() error = %v", err)
+			}
+
+			if match.Threshold != tt.threshold {
+				t.Errorf("compare() threshold = %v, want %v", match.Threshold, tt.threshold)
+			}
+			if match.Passed != tt.passed {
+				t.Errorf("compare() passed = %v with similarity %v, want %v", match.Passed, match.Similarity, tt.passed)
+			}
+			if match.Passed != (match.Similarity >= match.Threshold && len(found) == 0) {
+				t.Errorf("compare() passed = %v, similarity %v, threshold %v, reasons %v", match.Passed, match.Similarity, match.Threshold, found)
+			}
+
+			var got []string
+			for _, r := range found {
+				got = append(got, r.Code+"/"+r.Field)
+			}
+			if !reflect.DeepEqual(got, tt.reasons) {
+				t.Errorf("compare() reasons = %v, want %v", got, tt.reasons)
+			}
+		})
+	}
+}
+
+func TestCompareIsDeterministic(t *testing.T) {
+	w := &Worker{embedder: embedder.NewFake(), thresholds: Thresholds{Default: 0.4}}
+	document := photo{artifactType: proto.ArtifactType_PASSPORT.String(), image: []byte("passport photo")}
+	selfie := photo{artifactType: proto.ArtifactType_SELFIE.String(), image: []byte("selfie")}
+
+	first, _, err := w.compare(context.Background(), "PASSPORT", document, selfie)
+	if err != nil {
+		t.Fatalf("compare() error = %v", err)
+	}
+	second, _, err := w.compare(context.Background(), "PASSPORT", document, selfie)
+	if err != nil {
+		t.Fatalf("compare() error = %v", err)
+	}
+
+	if first.Similarity != second.Similarity {
+		t.Errorf("compare() similarity = %v then %v, want the same", first.Similarity, second.Similarity)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/face-match/internal/embedder"
+	"github.com/ekyc-backend/services/face-match/internal/repository"
+	"github.com/ekyc-backend/services/face-match/internal/server"
+	"github.com/ekyc-backend/services/face-match/internal/worker"
+	"go.uber.org/zap"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Face Match service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize encryption to read encrypted artifacts
+	kms, err := crypto.LoadKMS(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to load KMS", zap.Error(err))
+	}
+
+	// Initialize object storage
+	minioClient, err := storage.NewMinIO(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to MinIO", zap.Error(err))
+	}
+	objects := storage.NewEncryptedStore(minioClient, kms, storage.NewDataKeys(database), log)
+
+	// Initialize the face embedder and match thresholds
+	faces, err := embedder.New(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize face embedder", zap.Error(err))
+	}
+	defer faces.Close()
+	log.Info("Face embedder ready", zap.String("embedder", faces.Name()))
+
+	thresholds, err := worker.ParseThresholds(cfg.FaceMatchThreshold, cfg.FaceMatchThresholds)
+	if err != nil {
+		log.Fatal("Failed to parse face match thresholds", zap.Error(err))
+	}
+
+	// Initialize event bus
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// Match faces once a session has both a document and a selfie
+	faceWorker := worker.NewWorker(objects, faces, thresholds,
+		repository.NewArtifactRepository(database), repository.NewResultRepository(database), bus, log)
+	retry := events.WithRetryPolicy(events.RetryPolicy{
+		MaxAttempts:  cfg.FaceMatchMaxAttempts,
+		InitialDelay: cfg.FaceMatchRetryDelay,
+		MaxDelay:     cfg.FaceMatchMaxRetryDelay,
+	})
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, faceWorker.HandleArtifactUploaded, retry); err != nil {
+		log.Fatal("Failed to subscribe to artifact uploads", zap.Error(err))
+	}
+
+	// Initialize HTTP server for health checks
+	httpServer := server.NewHTTPServer(cfg)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}
@@ -5,8 +5,14 @@ go 1.22
 require (
 	github.com/ekyc-backend/pkg v0.0.0
 	github.com/gin-gonic/gin v1.9.1
+	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.5.3
 	github.com/nats-io/nats.go v1.33.1
+	go.uber.org/zap v1.26.0
+	golang.org/x/crypto v0.19.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240102182953-50ed04b92917
 	google.golang.org/grpc v1.61.0
+	google.golang.org/protobuf v1.32.0
 )
 
 replace github.com/ekyc-backend/pkg => ../../pkg
@@ -0,0 +1,375 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/services/identity/internal/session"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// Session is the persisted view of an eKYC session
+type Session struct {
+	ID           string
+	UserID       string
+	Status       session.Status
+	Score        *int32
+	PendingSteps []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Decision is a persisted admin decision
+type Decision struct {
+	ID        string
+	SessionID string
+	UserID    string
+	Status    string
+	Note      string
+	DecidedBy string
+	CreatedAt time.Time
+}
+
+// SessionRepository persists eKYC sessions and their transitions
+type SessionRepository struct {
+	db *db.DB
+}
+
+// NewSessionRepository creates a new session repository
+func NewSessionRepository(database *db.DB) *SessionRepository {
+	return &SessionRepository{db: database}
+}
+
+const sessionColumns = `id::text, COALESCE(user_id::text, ''), status::text, score, pending_steps, created_at, updated_at`
+
+// Create inserts a new session in the CREATED state
+func (r *SessionRepository) Create(ctx context.Context, userID string) (*Session, error) {
+	steps, err := json.Marshal(session.PendingSteps(session.StatusCreated))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pending steps: %w", err)
+	}
+
+	row := r.db.QueryRow(ctx, `
+		INSERT INTO ekyc_sessions (user_id, status, pending_steps)
+		VALUES ($1, $2::ekyc_status, $3)
+		RETURNING `+sessionColumns,
+		userID, string(session.StatusCreated), steps,
+	)
+
+	s, err := scanSession(row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return s, nil
+}
+
+// Get loads a session by ID
+func (r *SessionRepository) Get(ctx context.Context, sessionID string) (*Session, error) {
+	row := r.db.QueryRow(ctx, `SELECT `+sessionColumns+` FROM ekyc_sessions WHERE id = $1`, sessionID)
+
+	s, err := scanSession(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return s, nil
+}
+
+// Advance moves a session to a new state, and on to the state that follows
+// it if there is one, in a single transaction. The row is locked before its
+// state is read, so concurrent requests are serialized: a session that has
+// already reached the state is returned unchanged, and a failed follow-up
+// leaves the session where it was.
+func (r *SessionRepository) Advance(ctx context.Context, sessionID string, to session.Status, actor string) (*Session, error) {
+	var updated *Session
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		current, err := r.lock(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+
+		path, err := session.Path(current.Status, to)
+		if err != nil {
+			return err
+		}
+		for _, next := range path {
+			if current, err = r.move(ctx, tx, current, next, actor); err != nil {
+				return err
+			}
+		}
+
+		updated, err = r.review(ctx, tx, current, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Review puts a session under review once every check has stored its
+// result. It is called whenever a check reports; a session that is still
+// waiting for a check, or has moved past the checks, is returned unchanged.
+func (r *SessionRepository) Review(ctx context.Context, sessionID string, actor string) (*Session, error) {
+	var updated *Session
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		current, err := r.lock(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+
+		updated, err = r.review(ctx, tx, current, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// ApplyDecision records an admin decision and moves the session to the
+// matching terminal state, writing the decision event to the outbox
+func (r *SessionRepository) ApplyDecision(ctx context.Context, sessionID string, to session.Status, note, decidedBy string) (*Decision, error) {
+	var decision *Decision
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		updated, err := r.transition(ctx, tx, sessionID, to, decidedBy)
+		if err != nil {
+			return err
+		}
+
+		reasons, err := json.Marshal(map[string]string{"note": note})
+		if err != nil {
+			return fmt.Errorf("failed to marshal decision reasons: %w", err)
+		}
+
+		decision = &Decision{
+			SessionID: sessionID,
+			UserID:    updated.UserID,
+			Status:    string(to),
+			Note:      note,
+			DecidedBy: decidedBy,
+		}
+
+		err = tx.QueryRow(ctx, `
+			INSERT INTO ekyc_decisions (session_id, status, score, reasons_json, decided_by)
+			VALUES ($1, $2::decision_status, $3, $4, $5)
+			RETURNING id::text, created_at`,
+			sessionID, string(to), updated.Score, reasons, decidedBy,
+		).Scan(&decision.ID, &decision.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert decision: %w", err)
+		}
+
+		return events.Enqueue(events.WithSessionID(ctx, sessionID), tx, &proto.DecisionApplied{
+			DecisionId: decision.ID,
+			SessionId:  sessionID,
+			UserId:     decision.UserID,
+			Decision:   decision.Status,
+			Note:       note,
+			DecidedBy:  decidedBy,
+			DecidedAt:  timestamppb.New(decision.CreatedAt),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return decision, nil
+}
+
+// transition performs a locked state change inside an existing transaction
+func (r *SessionRepository) transition(ctx context.Context, tx pgx.Tx, sessionID string, to session.Status, actor string) (*Session, error) {
+	current, err := r.lock(ctx, tx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return r.move(ctx, tx, current, to, actor)
+}
+
+// ApplyScore records the score of an automated decision on a session under
+// review and moves it to the decided state. A session that is no longer
+// under review, because an admin decided it or it was already scored, is
+// returned unchanged.
+func (r *SessionRepository) ApplyScore(ctx context.Context, sessionID, decision string, score int32, actor string) (*Session, error) {
+	var updated *Session
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		current, err := r.lock(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+		if current.Status != session.StatusUnderReview {
+			updated = current
+			return nil
+		}
+
+		row := tx.QueryRow(ctx, `
+			UPDATE ekyc_sessions SET score = $2 WHERE id = $1
+			RETURNING `+sessionColumns,
+			sessionID, score,
+		)
+		if current, err = scanSession(row); err != nil {
+			return fmt.Errorf("failed to update session score: %w", err)
+		}
+
+		updated = current
+		if next, ok := session.Scored(current.Status, decision); ok {
+			updated, err = r.move(ctx, tx, current, next, actor)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// review moves a locked session under review if its checks are complete
+func (r *SessionRepository) review(ctx context.Context, tx pgx.Tx, current *Session, actor string) (*Session, error) {
+	if current.Status != session.StatusLivenessPending {
+		return current, nil
+	}
+
+	rows, err := tx.Query(ctx, `SELECT DISTINCT kind::text FROM ekyc_results WHERE session_id = $1`, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session results: %w", err)
+	}
+	defer rows.Close()
+
+	var kinds []string
+	for rows.Next() {
+		var kind string
+		if err := rows.Scan(&kind); err != nil {
+			return nil, fmt.Errorf("failed to scan session result: %w", err)
+		}
+		kinds = append(kinds, kind)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read session results: %w", err)
+	}
+
+	next, ok := session.Review(current.Status, kinds)
+	if !ok {
+		return current, nil
+	}
+	return r.move(ctx, tx, current, next, actor)
+}
+
+// lock loads a session and locks its row until the transaction ends
+func (r *SessionRepository) lock(ctx context.Context, tx pgx.Tx, sessionID string) (*Session, error) {
+	row := tx.QueryRow(ctx, `SELECT `+sessionColumns+` FROM ekyc_sessions WHERE id = $1 FOR UPDATE`, sessionID)
+
+	current, err := scanSession(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to lock session: %w", err)
+	}
+
+	return current, nil
+}
+
+// move changes the state of a locked session if the state machine allows it
+// and writes the status change event to the outbox, so the event is
+// published exactly when the transaction commits
+func (r *SessionRepository) move(ctx context.Context, tx pgx.Tx, current *Session, to session.Status, actor string) (*Session, error) {
+	sessionID := current.ID
+
+	pending, err := session.Transition(current.Status, to)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := json.Marshal(pending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pending steps: %w", err)
+	}
+
+	row := tx.QueryRow(ctx, `
+		UPDATE ekyc_sessions
+		SET status = $2::ekyc_status, pending_steps = $3
+		WHERE id = $1
+		RETURNING `+sessionColumns,
+		sessionID, string(to), steps,
+	)
+
+	updated, err := scanSession(row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update session status: %w", err)
+	}
+
+	meta, err := json.Marshal(map[string]string{"from": string(current.Status), "to": string(to)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit metadata: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO audit_logs (actor, action, session_id, meta_json)
+		VALUES ($1, $2, $3, $4)`,
+		actor, "session.transition", sessionID, meta,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	err = events.Enqueue(events.WithSessionID(ctx, sessionID), tx, &proto.SessionStatusChanged{
+		SessionId: sessionID,
+		UserId:    updated.UserID,
+		Status:    string(to),
+		Actor:     actor,
+		ChangedAt: timestamppb.New(updated.UpdatedAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// scanSession scans a row selected with sessionColumns
+func scanSession(row pgx.Row) (*Session, error) {
+	var (
+		s      Session
+		status string
+		steps  []byte
+	)
+
+	if err := row.Scan(&s.ID, &s.UserID, &status, &s.Score, &steps, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	parsed, err := session.ParseStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	s.Status = parsed
+
+	s.PendingSteps = []string{}
+	if len(steps) > 0 {
+		if err := json.Unmarshal(steps, &s.PendingSteps); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pending steps: %w", err)
+		}
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// withTx runs fn inside a transaction, committing on success and rolling back otherwise
+func withTx(ctx context.Context, database *db.DB, fn func(tx pgx.Tx) error) error {
+	tx, err := database.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
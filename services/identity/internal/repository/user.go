@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the Postgres SQLSTATE for unique constraint violations
+const uniqueViolation = "23505"
+
+// User is a registered account together with its roles
+type User struct {
+	ID           string
+	Email        string
+	PasswordHash string
+	Roles        []string
+	CreatedAt    time.Time
+	// DisabledAt is set once the account has been disabled
+	DisabledAt *time.Time
+}
+
+// Disabled reports whether the account has been disabled
+func (u *User) Disabled() bool {
+	return u.DisabledAt != nil
+}
+
+// UserRepository persists users and their roles
+type UserRepository struct {
+	db *db.DB
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(database *db.DB) *UserRepository {
+	return &UserRepository{db: database}
+}
+
+// Create inserts a user and its roles. A duplicate email is reported as
+// apperrors.ErrDuplicateRecord.
+func (r *UserRepository) Create(ctx context.Context, email, passwordHash string, roles []string) (*User, error) {
+	user := &User{
+		Email:        email,
+		PasswordHash: passwordHash,
+		Roles:        roles,
+	}
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO users (email, password_hash)
+			VALUES ($1, $2)
+			RETURNING id::text, created_at`,
+			email, passwordHash,
+		).Scan(&user.ID, &user.CreatedAt)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return apperrors.ErrDuplicateRecord
+			}
+			return fmt.Errorf("failed to insert user: %w", err)
+		}
+
+		for _, role := range roles {
+			_, err := tx.Exec(ctx, `INSERT INTO user_roles (user_id, role) VALUES ($1, $2)`, user.ID, role)
+			if err != nil {
+				return fmt.Errorf("failed to insert user role: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetByEmail loads a user and its roles by email
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*User, error) {
+	return r.get(ctx, "u.email = $1", email)
+}
+
+// GetByID loads a user and its roles by ID
+func (r *UserRepository) GetByID(ctx context.Context, userID string) (*User, error) {
+	return r.get(ctx, "u.id = $1", userID)
+}
+
+// get loads the user matching condition, which compares a users column to $1
+func (r *UserRepository) get(ctx context.Context, condition string, arg interface{}) (*User, error) {
+	var user User
+
+	err := r.db.QueryRow(ctx, `
+		SELECT u.id::text, u.email, u.password_hash, u.created_at, u.disabled_at,
+		       COALESCE(array_agg(ur.role ORDER BY ur.role) FILTER (WHERE ur.role IS NOT NULL), '{}')
+		FROM users u
+		LEFT JOIN user_roles ur ON ur.user_id = u.id
+		WHERE `+condition+`
+		GROUP BY u.id`,
+		arg,
+	).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.DisabledAt, &user.Roles)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/identity/internal/auth"
+	"github.com/ekyc-backend/services/identity/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	// DefaultRole is granted to every user on signup
+	DefaultRole = "USER"
+	// MinPasswordLength is the shortest password accepted on signup
+	MinPasswordLength = 8
+)
+
+// AuthServer implements the AuthService gRPC API
+type AuthServer struct {
+	proto.UnimplementedAuthServiceServer
+
+	users  *repository.UserRepository
+	logger *logger.Logger
+
+	// dummyHash is verified against when the email is unknown so that
+	// signin takes the same time whether or not the account exists
+	dummyHash string
+}
+
+// NewAuthServer creates a new auth gRPC server
+func NewAuthServer(users *repository.UserRepository, logger *logger.Logger) (*AuthServer, error) {
+	dummyHash, err := auth.HashPassword("dummy-password-for-timing")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthServer{
+		users:     users,
+		logger:    logger,
+		dummyHash: dummyHash,
+	}, nil
+}
+
+// SignUp registers a new user with the default role
+func (s *AuthServer) SignUp(ctx context.Context, req *proto.SignUpRequest) (*proto.SignUpResponse, error) {
+	email, err := normalizeEmail(req.GetEmail())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "email must be a valid address")
+	}
+	if len(req.GetPassword()) < MinPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", MinPasswordLength)
+	}
+
+	hash, err := auth.HashPassword(req.GetPassword())
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to hash password", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	user, err := s.users.Create(ctx, email, hash, []string{DefaultRole})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrDuplicateRecord) {
+			return nil, status.Error(codes.AlreadyExists, "email is already registered")
+		}
+		s.logger.WithContext(ctx).Error("Failed to create user", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	s.logger.WithContext(ctx).Info("User signed up", zap.String("user_id", user.ID))
+
+	return &proto.SignUpResponse{
+		UserId:  user.ID,
+		Message: "User registered successfully",
+	}, nil
+}
+
+// SignIn verifies a user's credentials and returns the user's roles
+func (s *AuthServer) SignIn(ctx context.Context, req *proto.SignInRequest) (*proto.SignInResponse, error) {
+	email, err := normalizeEmail(req.GetEmail())
+	if err != nil || req.GetPassword() == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+
+	user, err := s.users.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrRecordNotFound) {
+			_, _ = auth.VerifyPassword(req.GetPassword(), s.dummyHash)
+			return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+		}
+		s.logger.WithContext(ctx).Error("Failed to load user", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	ok, err := auth.VerifyPassword(req.GetPassword(), user.PasswordHash)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to verify password",
+			zap.String("user_id", user.ID),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+	if !ok {
+		s.logger.WithContext(ctx).Warn("Sign in failed", zap.String("user_id", user.ID))
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+	if user.Disabled() {
+		s.logger.WithContext(ctx).Warn("Sign in by disabled user", zap.String("user_id", user.ID))
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+
+	return &proto.SignInResponse{
+		UserId: user.ID,
+		Email:  user.Email,
+		Roles:  user.Roles,
+	}, nil
+}
+
+// GetUser returns the current roles and status of a user. The gateway calls
+// it on every token refresh, so roles are never taken from the refresh token.
+func (s *AuthServer) GetUser(ctx context.Context, req *proto.GetUserRequest) (*proto.GetUserResponse, error) {
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "user_id must be a valid UUID")
+	}
+
+	user, err := s.users.GetByID(ctx, req.GetUserId())
+	if err != nil {
+		if errors.Is(err, apperrors.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to load user",
+			zap.String("user_id", req.GetUserId()),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &proto.GetUserResponse{
+		UserId:   user.ID,
+		Email:    user.Email,
+		Roles:    user.Roles,
+		Disabled: user.Disabled(),
+	}, nil
+}
+
+// normalizeEmail validates an email address and returns its canonical lowercase form
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", errors.New("invalid email")
+	}
+	return email, nil
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+
+	"github.com/ekyc-backend/services/identity/internal/session"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// StorageActor is recorded in the audit log for transitions driven by uploads
+// that the storage service observed
+const StorageActor = "storage-svc"
+
+// uploadTargets maps each uploaded artifact type to the state it completes
+var uploadTargets = map[proto.ArtifactType]session.Status{
+	proto.ArtifactType_DOC_FRONT:     session.StatusDocUploaded,
+	proto.ArtifactType_DOC_BACK:      session.StatusDocUploaded,
+	proto.ArtifactType_PASSPORT:      session.StatusDocUploaded,
+	proto.ArtifactType_SELFIE:        session.StatusSelfieUploaded,
+	proto.ArtifactType_LIVENESS_CLIP: session.StatusLivenessPending,
+}
+
+// HandleArtifactUploaded advances a session when the storage service reports
+// a verified upload. Uploads that arrive out of order for the state machine
+// are logged and dropped; redelivered events are no-ops.
+func (s *IdentityServer) HandleArtifactUploaded(ctx context.Context, event *proto.ArtifactUploaded) error {
+	artifactType := proto.ArtifactType(proto.ArtifactType_value[event.GetArtifactType()])
+	to, ok := uploadTargets[artifactType]
+	if !ok {
+		return nil
+	}
+
+	_, err := s.uploaded(ctx, event.GetSessionId(), event.GetObjectKey(), artifactType, to, StorageActor)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition, codes.InvalidArgument, codes.NotFound:
+		s.logger.WithContext(ctx).WithSessionID(event.GetSessionId()).Warn("Ignoring artifact upload",
+			zap.String("artifact_id", event.GetArtifactId()),
+			zap.String("type", event.GetArtifactType()),
+			zap.Error(err),
+		)
+		return nil
+	default:
+		return err
+	}
+}
+
+// Actors recorded in the audit log when a check completes a session
+const (
+	OCRActor      = "doc-ocr"
+	FaceActor     = "face-match"
+	LivenessActor = "liveness"
+)
+
+// HandleOcrCompleted puts a session under review if its OCR result was the
+// last check outstanding
+func (s *IdentityServer) HandleOcrCompleted(ctx context.Context, event *proto.OcrCompleted) error {
+	return s.checkCompleted(ctx, event.GetSessionId(), event.GetResultId(), OCRActor)
+}
+
+// HandleFaceMatched puts a session under review if its face match was the
+// last check outstanding
+func (s *IdentityServer) HandleFaceMatched(ctx context.Context, event *proto.FaceMatched) error {
+	return s.checkCompleted(ctx, event.GetSessionId(), event.GetResultId(), FaceActor)
+}
+
+// HandleLivenessCompleted puts a session under review if its liveness result
+// was the last check outstanding
+func (s *IdentityServer) HandleLivenessCompleted(ctx context.Context, event *proto.LivenessCompleted) error {
+	return s.checkCompleted(ctx, event.GetSessionId(), event.GetResultId(), LivenessActor)
+}
+
+// checkCompleted reviews a session after one of its checks stored a result.
+// Results for unknown sessions are logged and dropped; redelivered events
+// are no-ops.
+func (s *IdentityServer) checkCompleted(ctx context.Context, sessionID, resultID, actor string) error {
+	err := s.Review(ctx, sessionID, actor)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument, codes.NotFound:
+		s.logger.WithContext(ctx).WithSessionID(sessionID).Warn("Ignoring check result",
+			zap.String("result_id", resultID),
+			zap.String("actor", actor),
+			zap.Error(err),
+		)
+		return nil
+	default:
+		return err
+	}
+}
+
+// ScoringActor is recorded in the audit log for transitions driven by
+// automated decisions
+const ScoringActor = "scoring"
+
+// HandleSessionScored applies an automated decision to a session under
+// review. APPROVED and REJECTED decide the session; REVIEW leaves it for an
+// admin. Decisions for sessions that left review are logged and dropped.
+func (s *IdentityServer) HandleSessionScored(ctx context.Context, event *proto.SessionScored) error {
+	err := s.ApplyScore(ctx, event.GetSessionId(), event.GetDecision(), event.GetScore())
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument, codes.NotFound:
+		s.logger.WithContext(ctx).WithSessionID(event.GetSessionId()).Warn("Ignoring automated decision",
+			zap.String("decision_id", event.GetDecisionId()),
+			zap.String("decision", event.GetDecision()),
+			zap.Error(err),
+		)
+		return nil
+	default:
+		return err
+	}
+}
@@ -0,0 +1,316 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/objectkey"
+	"github.com/ekyc-backend/services/identity/internal/repository"
+	"github.com/ekyc-backend/services/identity/internal/session"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	// errorDomain identifies errors raised by this service in ErrorInfo details
+	errorDomain = "identity.ekyc"
+	// ReasonIllegalTransition is the ErrorInfo reason for rejected state changes
+	ReasonIllegalTransition = "ILLEGAL_TRANSITION"
+)
+
+// IdentityServer implements the IdentityService gRPC API
+type IdentityServer struct {
+	proto.UnimplementedIdentityServiceServer
+
+	sessions *repository.SessionRepository
+	logger   *logger.Logger
+}
+
+// NewIdentityServer creates a new identity gRPC server
+func NewIdentityServer(sessions *repository.SessionRepository, logger *logger.Logger) *IdentityServer {
+	return &IdentityServer{
+		sessions: sessions,
+		logger:   logger,
+	}
+}
+
+// CreateSession starts a new eKYC session for a user
+func (s *IdentityServer) CreateSession(ctx context.Context, req *proto.CreateSessionRequest) (*proto.CreateSessionResponse, error) {
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "user_id must be a valid UUID")
+	}
+	if err := authorizeUser(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	created, err := s.sessions.Create(ctx, req.GetUserId())
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	s.logger.WithContext(ctx).WithSessionID(created.ID).Info("Session created",
+		zap.String("user_id", created.UserID),
+	)
+
+	return &proto.CreateSessionResponse{
+		SessionId: created.ID,
+		Status:    toProtoStatus(created.Status),
+		CreatedAt: timestamppb.New(created.CreatedAt),
+	}, nil
+}
+
+// GetSessionStatus returns the current state of a session
+func (s *IdentityServer) GetSessionStatus(ctx context.Context, req *proto.GetSessionStatusRequest) (*proto.GetSessionStatusResponse, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	current, err := s.sessions.Get(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+	if err := authorizeUser(ctx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	return toStatusResponse(current), nil
+}
+
+// ApplyAdminDecision approves or rejects a session that is under review
+func (s *IdentityServer) ApplyAdminDecision(ctx context.Context, req *proto.ApplyAdminDecisionRequest) (*proto.ApplyAdminDecisionResponse, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+	if req.GetDecidedBy() == "" {
+		return nil, status.Error(codes.InvalidArgument, "decided_by is required")
+	}
+
+	var target session.Status
+	switch req.GetDecision() {
+	case proto.DecisionStatus_DECISION_APPROVED:
+		target = session.StatusApproved
+	case proto.DecisionStatus_DECISION_REJECTED:
+		target = session.StatusRejected
+	default:
+		return nil, status.Error(codes.InvalidArgument, "decision must be APPROVED or REJECTED")
+	}
+
+	decision, err := s.sessions.ApplyDecision(ctx, req.GetSessionId(), target, req.GetNote(), req.GetDecidedBy())
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	s.logger.WithContext(ctx).WithSessionID(req.GetSessionId()).Info("Admin decision applied",
+		zap.String("decision", decision.Status),
+		zap.String("decided_by", decision.DecidedBy),
+	)
+
+	return &proto.ApplyAdminDecisionResponse{
+		SessionId: decision.SessionID,
+		Decision:  req.GetDecision(),
+		Note:      decision.Note,
+		DecidedAt: timestamppb.New(decision.CreatedAt),
+	}, nil
+}
+
+// DocumentUploaded records that an identity document has been uploaded.
+// Sessions normally advance from artifact.uploaded events; the upload RPCs
+// let an admin replay a transition by hand. Both sides of a card may be
+// confirmed, so a session already in DOC_UPLOADED accepts further documents.
+func (s *IdentityServer) DocumentUploaded(ctx context.Context, req *proto.DocumentUploadedRequest) (*proto.UploadNotificationResponse, error) {
+	switch req.GetType() {
+	case proto.ArtifactType_DOC_FRONT, proto.ArtifactType_DOC_BACK, proto.ArtifactType_PASSPORT:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "type must be DOC_FRONT, DOC_BACK or PASSPORT")
+	}
+
+	return s.uploaded(ctx, req.GetSessionId(), req.GetKey(), req.GetType(), session.StatusDocUploaded, callerID(ctx))
+}
+
+// SelfieUploaded records that a selfie has been uploaded
+func (s *IdentityServer) SelfieUploaded(ctx context.Context, req *proto.SelfieUploadedRequest) (*proto.UploadNotificationResponse, error) {
+	return s.uploaded(ctx, req.GetSessionId(), req.GetKey(), proto.ArtifactType_SELFIE, session.StatusSelfieUploaded, callerID(ctx))
+}
+
+// LivenessUploaded records that a liveness clip has been uploaded and is
+// awaiting analysis
+func (s *IdentityServer) LivenessUploaded(ctx context.Context, req *proto.LivenessUploadedRequest) (*proto.UploadNotificationResponse, error) {
+	return s.uploaded(ctx, req.GetSessionId(), req.GetKey(), proto.ArtifactType_LIVENESS_CLIP, session.StatusLivenessPending, callerID(ctx))
+}
+
+// uploaded checks ownership and the object key of an upload notification and
+// advances the session to the given state, and on to its follow-up state if
+// it has one. Notifications for a state the session already reached are
+// no-ops.
+func (s *IdentityServer) uploaded(ctx context.Context, sessionID, key string, artifactType proto.ArtifactType, to session.Status, actor string) (*proto.UploadNotificationResponse, error) {
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+	parsed, err := objectkey.Parse(key)
+	if err != nil || parsed.SessionID != sessionID || parsed.ArtifactType != artifactType.String() {
+		return nil, status.Error(codes.InvalidArgument, "key must belong to the session and artifact type")
+	}
+
+	current, err := s.sessions.Get(ctx, sessionID)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+	if err := authorizeUser(ctx, current.UserID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.Advance(ctx, sessionID, to, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUploadResponse(updated), nil
+}
+
+// Advance moves a session forward through the verification pipeline, on to
+// the follow-up of the requested state if it has one. It is used by internal
+// flows (upload confirmation, scoring) rather than being exposed to end users
+// directly.
+func (s *IdentityServer) Advance(ctx context.Context, sessionID string, to session.Status, actor string) (*proto.GetSessionStatusResponse, error) {
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	updated, err := s.sessions.Advance(ctx, sessionID, to, actor)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	s.logger.WithContext(ctx).WithSessionID(sessionID).Info("Session advanced",
+		zap.String("status", string(updated.Status)),
+		zap.String("actor", actor),
+	)
+
+	return toStatusResponse(updated), nil
+}
+
+// Review puts a session under review once all of its checks have reported
+func (s *IdentityServer) Review(ctx context.Context, sessionID, actor string) error {
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	updated, err := s.sessions.Review(ctx, sessionID, actor)
+	if err != nil {
+		return s.toStatusError(ctx, err)
+	}
+
+	if updated.Status == session.StatusUnderReview {
+		s.logger.WithContext(ctx).WithSessionID(sessionID).Info("Session under review",
+			zap.String("actor", actor),
+		)
+	}
+	return nil
+}
+
+// ApplyScore records an automated decision on a session under review and
+// moves the session to the decided state
+func (s *IdentityServer) ApplyScore(ctx context.Context, sessionID, decision string, score int32) error {
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	updated, err := s.sessions.ApplyScore(ctx, sessionID, decision, score, ScoringActor)
+	if err != nil {
+		return s.toStatusError(ctx, err)
+	}
+
+	s.logger.WithContext(ctx).WithSessionID(sessionID).Info("Automated decision applied",
+		zap.String("decision", decision),
+		zap.Int32("score", score),
+		zap.String("status", string(updated.Status)),
+	)
+	return nil
+}
+
+// callerID returns the user ID of the authenticated caller, if any
+func callerID(ctx context.Context) string {
+	if claims, ok := grpcmw.ClaimsFromContext(ctx); ok {
+		return claims.UserID
+	}
+	return ""
+}
+
+// authorizeUser allows a call on behalf of userID only for that user or an admin.
+// Calls without token claims have already been vetted by the auth policy.
+func authorizeUser(ctx context.Context, userID string) error {
+	claims, ok := grpcmw.ClaimsFromContext(ctx)
+	if !ok || claims.UserID == userID || claims.HasRole("ADMIN") {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "session does not belong to the caller")
+}
+
+// toStatusError maps domain and repository errors to gRPC status errors
+func (s *IdentityServer) toStatusError(ctx context.Context, err error) error {
+	var transitionErr *session.TransitionError
+
+	switch {
+	case errors.As(err, &transitionErr):
+		st := status.New(codes.FailedPrecondition, transitionErr.Error())
+		detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: ReasonIllegalTransition,
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"from": string(transitionErr.From),
+				"to":   string(transitionErr.To),
+			},
+		})
+		if detailErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	case errors.Is(err, apperrors.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "session not found")
+	default:
+		s.logger.WithContext(ctx).Error("Identity request failed", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// toStatusResponse converts a persisted session into a status response
+func toStatusResponse(s *repository.Session) *proto.GetSessionStatusResponse {
+	resp := &proto.GetSessionStatusResponse{
+		SessionId:    s.ID,
+		UserId:       s.UserID,
+		Status:       toProtoStatus(s.Status),
+		PendingSteps: s.PendingSteps,
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+	}
+	if s.Score != nil {
+		resp.Score = *s.Score
+	}
+	return resp
+}
+
+// toUploadResponse converts a status response into an upload notification response
+func toUploadResponse(s *proto.GetSessionStatusResponse) *proto.UploadNotificationResponse {
+	return &proto.UploadNotificationResponse{
+		SessionId:    s.GetSessionId(),
+		Status:       s.GetStatus(),
+		PendingSteps: s.GetPendingSteps(),
+		UpdatedAt:    s.GetUpdatedAt(),
+	}
+}
+
+// toProtoStatus converts a session status into its protobuf enum
+func toProtoStatus(s session.Status) proto.SessionStatus {
+	if value, ok := proto.SessionStatus_value[string(s)]; ok {
+		return proto.SessionStatus(value)
+	}
+	return proto.SessionStatus_SESSION_STATUS_UNSPECIFIED
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/gin-gonic/gin"
+)
+
+// NewHTTPServer creates the HTTP server exposing health endpoints
+func NewHTTPServer(cfg *config.Config) *http.Server {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"status":    "alive",
+			"timestamp": time.Now().UTC().Format(time.RFC3339),
+			"service":   cfg.ServiceName,
+		})
+	})
+
+	return &http.Server{
+		Addr:         cfg.GetHTTPAddr(),
+		Handler:      router,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 5 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+}
@@ -0,0 +1,180 @@
+package session
+
+import (
+	"fmt"
+)
+
+// Status represents the lifecycle state of an eKYC session.
+// Values mirror the ekyc_status enum in the database.
+type Status string
+
+const (
+	StatusCreated         Status = "CREATED"
+	StatusDocUploaded     Status = "DOC_UPLOADED"
+	StatusSelfieUploaded  Status = "SELFIE_UPLOADED"
+	StatusLivenessPending Status = "LIVENESS_PENDING"
+	StatusUnderReview     Status = "UNDER_REVIEW"
+	StatusApproved        Status = "APPROVED"
+	StatusRejected        Status = "REJECTED"
+)
+
+// Verification steps reported in pending_steps
+const (
+	StepDocument = "DOCUMENT"
+	StepSelfie   = "SELFIE"
+	StepLiveness = "LIVENESS"
+	StepReview   = "REVIEW"
+)
+
+// Kinds of check results stored in ekyc_results. Values mirror the
+// result_kind enum in the database.
+const (
+	ResultOCR      = "OCR"
+	ResultFace     = "FACE"
+	ResultLiveness = "LIVENESS"
+)
+
+// requiredResults lists the checks that must all report before a session can
+// be reviewed
+var requiredResults = []string{ResultOCR, ResultFace, ResultLiveness}
+
+// transitions lists the legal next states for each state
+var transitions = map[Status][]Status{
+	StatusCreated:         {StatusDocUploaded},
+	StatusDocUploaded:     {StatusSelfieUploaded},
+	StatusSelfieUploaded:  {StatusLivenessPending},
+	StatusLivenessPending: {StatusUnderReview},
+	StatusUnderReview:     {StatusApproved, StatusRejected},
+	StatusApproved:        {},
+	StatusRejected:        {},
+}
+
+// followUps lists states that are left as soon as they are entered. Once the
+// selfie is in, the session waits for the liveness check, which is what
+// prompts the liveness service to issue a challenge.
+var followUps = map[Status]Status{
+	StatusSelfieUploaded: StatusLivenessPending,
+}
+
+// pendingSteps lists the steps still outstanding in each state
+var pendingSteps = map[Status][]string{
+	StatusCreated:         {StepDocument, StepSelfie, StepLiveness},
+	StatusDocUploaded:     {StepSelfie, StepLiveness},
+	StatusSelfieUploaded:  {StepLiveness},
+	StatusLivenessPending: {StepLiveness},
+	StatusUnderReview:     {StepReview},
+	StatusApproved:        {},
+	StatusRejected:        {},
+}
+
+// TransitionError is returned when a requested transition is not allowed
+type TransitionError struct {
+	From Status
+	To   Status
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("illegal session transition from %s to %s", e.From, e.To)
+}
+
+// ParseStatus converts a raw status string into a Status
+func ParseStatus(s string) (Status, error) {
+	status := Status(s)
+	if _, ok := transitions[status]; !ok {
+		return "", fmt.Errorf("unknown session status: %s", s)
+	}
+	return status, nil
+}
+
+// IsTerminal reports whether no further transitions are possible
+func (s Status) IsTerminal() bool {
+	return len(transitions[s]) == 0
+}
+
+// CanTransition reports whether moving from one state to another is legal
+func CanTransition(from, to Status) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition validates a state change and returns the pending steps for the new state
+func Transition(from, to Status) ([]string, error) {
+	if !CanTransition(from, to) {
+		return nil, &TransitionError{From: from, To: to}
+	}
+	return PendingSteps(to), nil
+}
+
+// FollowUp returns the state a session moves on to right after entering s
+func FollowUp(s Status) (Status, bool) {
+	next, ok := followUps[s]
+	return next, ok
+}
+
+// Path returns the states a session in current passes through to reach to:
+// to itself, then its follow-up if it has one. A session that is already in
+// to, or has moved on to its follow-up, has nothing left to do, so a
+// repeated request is a no-op rather than an illegal transition.
+func Path(current, to Status) ([]Status, error) {
+	next, hasFollowUp := FollowUp(to)
+	if current == to || (hasFollowUp && current == next) {
+		return nil, nil
+	}
+	if !CanTransition(current, to) {
+		return nil, &TransitionError{From: current, To: to}
+	}
+
+	path := []Status{to}
+	if hasFollowUp {
+		path = append(path, next)
+	}
+	return path, nil
+}
+
+// Review returns the state a session in current moves on to once results of
+// the given kinds are stored. A session waiting for liveness goes under
+// review as soon as the document, face and liveness checks have all
+// reported; in any other state, or while a check is outstanding, it stays
+// where it is.
+func Review(current Status, results []string) (Status, bool) {
+	if current != StatusLivenessPending {
+		return "", false
+	}
+
+	stored := make(map[string]bool, len(results))
+	for _, kind := range results {
+		stored[kind] = true
+	}
+	for _, kind := range requiredResults {
+		if !stored[kind] {
+			return "", false
+		}
+	}
+	return StatusUnderReview, true
+}
+
+// Scored returns the state a session under review moves on to when scoring
+// reaches the given decision. Sessions scored for REVIEW, or scored after
+// they left review, stay where they are.
+func Scored(current Status, decision string) (Status, bool) {
+	if current != StatusUnderReview {
+		return "", false
+	}
+	switch Status(decision) {
+	case StatusApproved, StatusRejected:
+		return Status(decision), true
+	default:
+		return "", false
+	}
+}
+
+// PendingSteps returns a copy of the outstanding steps for a state
+func PendingSteps(s Status) []string {
+	steps := make([]string, len(pendingSteps[s]))
+	copy(steps, pendingSteps[s])
+	return steps
+}
@@ -0,0 +1,240 @@
+package session
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from Status
+		to   Status
+		want bool
+	}{
+		{StatusCreated, StatusDocUploaded, true},
+		{StatusDocUploaded, StatusSelfieUploaded, true},
+		{StatusSelfieUploaded, StatusLivenessPending, true},
+		{StatusLivenessPending, StatusUnderReview, true},
+		{StatusUnderReview, StatusApproved, true},
+		{StatusUnderReview, StatusRejected, true},
+		{StatusCreated, StatusSelfieUploaded, false},
+		{StatusCreated, StatusApproved, false},
+		{StatusDocUploaded, StatusCreated, false},
+		{StatusLivenessPending, StatusApproved, false},
+		{StatusUnderReview, StatusUnderReview, false},
+		{StatusApproved, StatusRejected, false},
+		{StatusRejected, StatusApproved, false},
+		{Status("UNKNOWN"), StatusCreated, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			if got := CanTransition(tt.from, tt.to); got != tt.want {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPendingSteps(t *testing.T) {
+	tests := []struct {
+		status Status
+		want   []string
+	}{
+		{StatusCreated, []string{StepDocument, StepSelfie, StepLiveness}},
+		{StatusDocUploaded, []string{StepSelfie, StepLiveness}},
+		{StatusSelfieUploaded, []string{StepLiveness}},
+		{StatusLivenessPending, []string{StepLiveness}},
+		{StatusUnderReview, []string{StepReview}},
+		{StatusApproved, []string{}},
+		{StatusRejected, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := PendingSteps(tt.status); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PendingSteps(%s) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPendingStepsReturnsCopy(t *testing.T) {
+	steps := PendingSteps(StatusCreated)
+	steps[0] = "MUTATED"
+
+	if got := PendingSteps(StatusCreated)[0]; got != StepDocument {
+		t.Errorf("PendingSteps(%s)[0] = %q after caller mutation, want %q", StatusCreated, got, StepDocument)
+	}
+}
+
+func TestTransition(t *testing.T) {
+	steps, err := Transition(StatusCreated, StatusDocUploaded)
+	if err != nil {
+		t.Fatalf("Transition(%s, %s) error = %v", StatusCreated, StatusDocUploaded, err)
+	}
+	if want := PendingSteps(StatusDocUploaded); !reflect.DeepEqual(steps, want) {
+		t.Errorf("Transition(%s, %s) = %v, want %v", StatusCreated, StatusDocUploaded, steps, want)
+	}
+
+	_, err = Transition(StatusCreated, StatusApproved)
+	var transitionErr *TransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("Transition(%s, %s) error = %v, want *TransitionError", StatusCreated, StatusApproved, err)
+	}
+	if transitionErr.From != StatusCreated || transitionErr.To != StatusApproved {
+		t.Errorf("TransitionError = %+v, want from %s to %s", transitionErr, StatusCreated, StatusApproved)
+	}
+}
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		current Status
+		to      Status
+		want    []Status
+		wantErr bool
+	}{
+		{name: "document upload", current: StatusCreated, to: StatusDocUploaded, want: []Status{StatusDocUploaded}},
+		{name: "selfie upload moves on to liveness", current: StatusDocUploaded, to: StatusSelfieUploaded, want: []Status{StatusSelfieUploaded, StatusLivenessPending}},
+		{name: "repeated document upload", current: StatusDocUploaded, to: StatusDocUploaded},
+		{name: "repeated selfie upload after follow-up", current: StatusLivenessPending, to: StatusSelfieUploaded},
+		{name: "selfie before document", current: StatusCreated, to: StatusSelfieUploaded, wantErr: true},
+		{name: "document after selfie", current: StatusLivenessPending, to: StatusDocUploaded, wantErr: true},
+		{name: "terminal state", current: StatusApproved, to: StatusRejected, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Path(tt.current, tt.to)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Path(%s, %s) error = %v, want error %v", tt.current, tt.to, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Path(%s, %s) = %v, want %v", tt.current, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReview(t *testing.T) {
+	all := []string{ResultOCR, ResultFace, ResultLiveness}
+
+	tests := []struct {
+		name    string
+		current Status
+		results []string
+		want    Status
+		wantOK  bool
+	}{
+		{name: "all checks reported", current: StatusLivenessPending, results: all, want: StatusUnderReview, wantOK: true},
+		{name: "repeated results", current: StatusLivenessPending, results: []string{ResultOCR, ResultOCR, ResultLiveness, ResultFace}, want: StatusUnderReview, wantOK: true},
+		{name: "liveness outstanding", current: StatusLivenessPending, results: []string{ResultOCR, ResultFace}},
+		{name: "no results", current: StatusLivenessPending},
+		{name: "selfie not uploaded", current: StatusDocUploaded, results: all},
+		{name: "already under review", current: StatusUnderReview, results: all},
+		{name: "decided", current: StatusApproved, results: all},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Review(tt.current, tt.results)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Review(%s, %v) = %s, %v, want %s, %v", tt.current, tt.results, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestScored(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  Status
+		decision string
+		want     Status
+		wantOK   bool
+	}{
+		{name: "approved", current: StatusUnderReview, decision: "APPROVED", want: StatusApproved, wantOK: true},
+		{name: "rejected", current: StatusUnderReview, decision: "REJECTED", want: StatusRejected, wantOK: true},
+		{name: "left for an admin", current: StatusUnderReview, decision: "REVIEW"},
+		{name: "unknown decision", current: StatusUnderReview, decision: "MAYBE"},
+		{name: "decided by an admin first", current: StatusRejected, decision: "APPROVED"},
+		{name: "not under review", current: StatusLivenessPending, decision: "APPROVED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Scored(tt.current, tt.decision)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Scored(%s, %s) = %s, %v, want %s, %v", tt.current, tt.decision, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// TestPipeline drives a session from creation to approval the way identity
+// does: upload notifications advance it along their path, each stored check
+// result is followed by a review, and the automated decision closes it.
+func TestPipeline(t *testing.T) {
+	current := StatusCreated
+	var results []string
+
+	advance := func(to Status) {
+		t.Helper()
+		path, err := Path(current, to)
+		if err != nil {
+			t.Fatalf("Path(%s, %s) error = %v", current, to, err)
+		}
+		for _, next := range path {
+			if _, err := Transition(current, next); err != nil {
+				t.Fatalf("Transition(%s, %s) error = %v", current, next, err)
+			}
+			current = next
+		}
+	}
+	report := func(kind string) {
+		t.Helper()
+		results = append(results, kind)
+		if next, ok := Review(current, results); ok {
+			if _, err := Transition(current, next); err != nil {
+				t.Fatalf("Transition(%s, %s) error = %v", current, next, err)
+			}
+			current = next
+		}
+	}
+
+	advance(StatusDocUploaded)
+	advance(StatusDocUploaded)
+	report(ResultOCR)
+	if current != StatusDocUploaded {
+		t.Fatalf("status after OCR = %s, want %s", current, StatusDocUploaded)
+	}
+
+	advance(StatusSelfieUploaded)
+	if current != StatusLivenessPending {
+		t.Fatalf("status after selfie = %s, want %s", current, StatusLivenessPending)
+	}
+	report(ResultFace)
+	if current != StatusLivenessPending {
+		t.Fatalf("status after face match = %s, want %s", current, StatusLivenessPending)
+	}
+
+	advance(StatusLivenessPending)
+	report(ResultLiveness)
+	if current != StatusUnderReview {
+		t.Fatalf("status after liveness = %s, want %s", current, StatusUnderReview)
+	}
+	if got := PendingSteps(current); !reflect.DeepEqual(got, []string{StepReview}) {
+		t.Errorf("PendingSteps(%s) = %v, want [%s]", current, got, StepReview)
+	}
+
+	next, ok := Scored(current, "APPROVED")
+	if !ok {
+		t.Fatalf("Scored(%s, APPROVED) = %s, false, want a transition", current, next)
+	}
+	advance(next)
+	if current != StatusApproved || !current.IsTerminal() {
+		t.Fatalf("status after decision = %s, want terminal %s", current, StatusApproved)
+	}
+}
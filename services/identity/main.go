@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/identity/internal/repository"
+	"github.com/ekyc-backend/services/identity/internal/server"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Identity service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize event bus for upload, check and scoring events and session
+	// status changes
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// Initialize repositories and gRPC handlers
+	sessions := repository.NewSessionRepository(database)
+	users := repository.NewUserRepository(database)
+	identityServer := server.NewIdentityServer(sessions, log)
+
+	// Publish the session status changes and decisions written to the outbox
+	relay, err := events.NewOutboxRelay(database, bus, cfg, log)
+	if err != nil {
+		log.Fatal("Failed to initialize outbox relay", zap.Error(err))
+	}
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		relay.Run(relayCtx)
+	}()
+
+	authServer, err := server.NewAuthServer(users, log)
+	if err != nil {
+		log.Fatal("Failed to initialize auth server", zap.Error(err))
+	}
+
+	// Advance sessions on uploads observed by the storage service
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, identityServer.HandleArtifactUploaded); err != nil {
+		log.Fatal("Failed to subscribe to artifact uploads", zap.Error(err))
+	}
+
+	// Put sessions under review once the document, face and liveness checks
+	// have all reported
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, identityServer.HandleOcrCompleted); err != nil {
+		log.Fatal("Failed to subscribe to OCR results", zap.Error(err))
+	}
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, identityServer.HandleFaceMatched); err != nil {
+		log.Fatal("Failed to subscribe to face match results", zap.Error(err))
+	}
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, identityServer.HandleLivenessCompleted); err != nil {
+		log.Fatal("Failed to subscribe to liveness results", zap.Error(err))
+	}
+
+	// Apply the automated decisions scoring makes for sessions under review
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, identityServer.HandleSessionScored); err != nil {
+		log.Fatal("Failed to subscribe to automated decisions", zap.Error(err))
+	}
+
+	// Initialize Redis for the token denylist written by the gateway
+	redisClient, err := storage.NewRedis(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to Redis", zap.Error(err))
+	}
+	defer redisClient.Close()
+
+	// Initialize token verification against the gateway's published keys and
+	// revocations
+	verifier := jwtkeys.NewVerifier(jwtkeys.NewRemoteKeySet(cfg.JWKSURL, jwtkeys.DefaultJWKSRefreshInterval), cfg.JWTIssuer)
+	denylist := jwtkeys.NewDenylist(redisClient)
+
+	policy := grpcmw.NewAuthPolicy().
+		RequireAnyRole("/ekyc.IdentityService/CreateSession", "USER", "ADMIN").
+		RequireAnyRole("/ekyc.IdentityService/GetSessionStatus", "USER", "ADMIN").
+		RequireAnyRole("/ekyc.IdentityService/DocumentUploaded", "ADMIN").
+		RequireAnyRole("/ekyc.IdentityService/SelfieUploaded", "ADMIN").
+		RequireAnyRole("/ekyc.IdentityService/LivenessUploaded", "ADMIN").
+		RequireAnyRole("/ekyc.IdentityService/ApplyAdminDecision", "ADMIN")
+
+	// Initialize mTLS for service-to-service traffic
+	tlsSource, err := mtls.Load(mtls.OptionsFromConfig(cfg), log)
+	if err != nil {
+		log.Fatal("Failed to load mTLS configuration", zap.Error(err))
+	}
+	if tlsSource != nil {
+		defer tlsSource.Close()
+	}
+
+	// The gateway reads a user's roles on refresh, when it holds no access
+	// token. Only the mTLS peer policy below keeps anyone else from reading
+	// users that way, so without mTLS GetUser needs an admin token and
+	// refreshes through the gateway fail instead.
+	if tlsSource != nil {
+		policy.Public("/ekyc.AuthService/GetUser")
+	} else {
+		policy.RequireAnyRole("/ekyc.AuthService/GetUser", "ADMIN")
+		log.Warn("mTLS is not configured, GetUser requires an admin token and token refresh is unavailable")
+	}
+
+	// The gateway calls this service, and the admin service applies admin
+	// decisions. The gateway reads users without an access token, so GetUser
+	// is restricted to it explicitly.
+	peers := mtls.NewPeerPolicy(cfg.TLSTrustDomain).
+		Allow("api-gateway").
+		AllowMethod("/ekyc.AuthService/GetUser", "api-gateway").
+		AllowMethod("/ekyc.IdentityService/ApplyAdminDecision", "api-gateway", "admin")
+
+	// Initialize gRPC server
+	serverOptions := append(mtls.ServerOptions(tlsSource, peers),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryTracingInterceptor(cfg.ServiceName),
+			grpcmw.UnaryLoggingInterceptor(log),
+			grpcmw.UnaryAuthInterceptor(verifier, denylist, policy, log),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamTracingInterceptor(cfg.ServiceName),
+			grpcmw.StreamLoggingInterceptor(log),
+			grpcmw.StreamAuthInterceptor(verifier, denylist, policy, log),
+		),
+	)
+	grpcServer := grpc.NewServer(serverOptions...)
+	proto.RegisterIdentityServiceServer(grpcServer, identityServer)
+	proto.RegisterAuthServiceServer(grpcServer, authServer)
+
+	listener, err := net.Listen("tcp", cfg.GetGRPCAddr())
+	if err != nil {
+		log.Fatal("Failed to listen for gRPC", zap.Error(err))
+	}
+
+	go func() {
+		log.Info("Starting gRPC server", zap.String("addr", cfg.GetGRPCAddr()))
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	// Initialize HTTP server for health checks
+	httpServer := server.NewHTTPServer(cfg)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcServer.GracefulStop()
+
+	// Stop the relay after the last transition; unsent events are published
+	// by the next relay to run
+	stopRelay()
+	<-relayDone
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}
@@ -0,0 +1,61 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+)
+
+// Analyzer names accepted in LIVENESS_ANALYZER
+const (
+	AnalyzerHTTP = "http"
+	AnalyzerFake = "fake"
+)
+
+// Media is an encoded clip or frame
+type Media struct {
+	Data        []byte
+	ContentType string
+}
+
+// Analysis is what an analyzer found in a clip or frame. The liveness service
+// decides the outcome: it compares the observed actions and digits with the
+// challenge and the score with its threshold.
+type Analysis struct {
+	// Score is the probability in [0, 1] that a live person was recorded
+	Score float32
+	// Actions are the head movements and blinks seen in a clip, in order
+	Actions []string
+	// Digits are the digits heard in a clip
+	Digits string
+	// Metrics are analyzer specific signals kept with the result
+	Metrics map[string]float32
+}
+
+// Analyzer judges whether media shows a live person
+type Analyzer interface {
+	// Name identifies the analyzer in stored results
+	Name() string
+	// Active analyzes a clip recorded in response to a challenge and reports
+	// what the person did in it
+	Active(ctx context.Context, clip Media, c *challenge.Challenge) (*Analysis, error)
+	// Passive analyzes a single frame for presentation attacks such as
+	// printed photos and screens
+	Passive(ctx context.Context, frame Media) (*Analysis, error)
+	// Close releases the resources held by the analyzer
+	Close() error
+}
+
+// New builds the analyzer selected by the configuration
+func New(cfg *config.Config) (Analyzer, error) {
+	switch cfg.LivenessAnalyzer {
+	case AnalyzerHTTP:
+		return NewHTTP(cfg.LivenessAnalyzerURL), nil
+	case AnalyzerFake:
+		return NewFake(), nil
+	default:
+		return nil, fmt.Errorf("unsupported liveness analyzer %q", cfg.LivenessAnalyzer)
+	}
+}
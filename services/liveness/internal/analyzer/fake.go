@@ -0,0 +1,48 @@
+package analyzer
+
+import (
+	"context"
+
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+)
+
+// Fake reports every non-empty clip as a live person performing the challenge
+// exactly, and every non-empty frame as live. Challenge issuance, expiry and
+// replay checks still apply in full. It needs no model, for tests and local
+// runs; LIVENESS_FORCE_FAIL exercises the failure path.
+type Fake struct{}
+
+// NewFake creates a fake analyzer
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// Name returns the analyzer name
+func (f *Fake) Name() string {
+	return AnalyzerFake
+}
+
+// Active echoes the challenge
+func (f *Fake) Active(ctx context.Context, clip Media, c *challenge.Challenge) (*Analysis, error) {
+	if len(clip.Data) == 0 {
+		return &Analysis{}, nil
+	}
+	return &Analysis{
+		Score:   1,
+		Actions: append([]string(nil), c.Actions...),
+		Digits:  c.Digits,
+	}, nil
+}
+
+// Passive scores non-empty frames as live
+func (f *Fake) Passive(ctx context.Context, frame Media) (*Analysis, error) {
+	if len(frame.Data) == 0 {
+		return &Analysis{}, nil
+	}
+	return &Analysis{Score: 1}, nil
+}
+
+// Close does nothing
+func (f *Fake) Close() error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package analyzer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+)
+
+const (
+	// httpTimeout bounds one analysis, which for clips includes uploading up
+	// to the largest accepted video
+	httpTimeout = 60 * time.Second
+	// maxResponseSize bounds the analyzer's JSON answer
+	maxResponseSize = 1 << 20
+)
+
+// HTTP delegates analysis to a model server. Media is posted as multipart
+// form data to {url}/v1/liveness/active or {url}/v1/liveness/passive, and the
+// server answers with JSON in the shape of httpAnalysis. Active requests only
+// name the challenge kind, never the expected actions or digits, so the
+// server has to report what it actually observed.
+type HTTP struct {
+	url    string
+	client *http.Client
+}
+
+// httpAnalysis is the model server's answer
+type httpAnalysis struct {
+	Score   float32            `json:"score"`
+	Actions []string           `json:"actions"`
+	Digits  string             `json:"digits"`
+	Metrics map[string]float32 `json:"metrics"`
+}
+
+// NewHTTP creates an analyzer backed by the model server at url
+func NewHTTP(url string) *HTTP {
+	return &HTTP{
+		url:    strings.TrimRight(url, "/"),
+		client: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+// Name returns the analyzer name
+func (h *HTTP) Name() string {
+	return AnalyzerHTTP
+}
+
+// Active asks the model server what the person does in a clip
+func (h *HTTP) Active(ctx context.Context, clip Media, c *challenge.Challenge) (*Analysis, error) {
+	return h.analyze(ctx, "/v1/liveness/active", clip, map[string]string{"kind": c.Kind})
+}
+
+// Passive asks the model server whether a frame shows a live person
+func (h *HTTP) Passive(ctx context.Context, frame Media) (*Analysis, error) {
+	return h.analyze(ctx, "/v1/liveness/passive", frame, nil)
+}
+
+// Close releases idle connections
+func (h *HTTP) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
+
+// analyze posts media and form fields to path and decodes the answer
+func (h *HTTP) analyze(ctx context.Context, path string, media Media, fields map[string]string) (*Analysis, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			return nil, fmt.Errorf("failed to write liveness request: %w", err)
+		}
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="media"; filename="media"`)
+	header.Set("Content-Type", media.ContentType)
+	part, err := form.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write liveness request: %w", err)
+	}
+	if _, err := part.Write(media.Data); err != nil {
+		return nil, fmt.Errorf("failed to write liveness request: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write liveness request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url+path, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create liveness request: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call liveness analyzer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("liveness analyzer returned status %d", resp.StatusCode)
+	}
+
+	var answer httpAnalysis
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&answer); err != nil {
+		return nil, fmt.Errorf("failed to decode liveness analysis: %w", err)
+	}
+	if answer.Score < 0 || answer.Score > 1 {
+		return nil, fmt.Errorf("liveness analyzer returned score %v outside [0, 1]", answer.Score)
+	}
+
+	return &Analysis{
+		Score:   answer.Score,
+		Actions: answer.Actions,
+		Digits:  answer.Digits,
+		Metrics: answer.Metrics,
+	}, nil
+}
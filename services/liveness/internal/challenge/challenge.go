@@ -0,0 +1,158 @@
+package challenge
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// Challenge kinds, the LivenessChallengeKind names without their prefix
+const (
+	KindHeadTurn = "HEAD_TURN"
+	KindBlink    = "BLINK"
+	KindDigits   = "DIGITS"
+)
+
+// Actions the user may be asked to perform
+const (
+	ActionTurnLeft  = "TURN_LEFT"
+	ActionTurnRight = "TURN_RIGHT"
+	ActionLookUp    = "LOOK_UP"
+	ActionLookDown  = "LOOK_DOWN"
+	ActionBlink     = "BLINK"
+)
+
+const (
+	// headTurns is the number of head movements in a HEAD_TURN challenge
+	headTurns = 3
+	// minBlinks and maxBlinks bound the blinks asked for in a BLINK challenge
+	minBlinks = 2
+	maxBlinks = 5
+	// digitCount is the number of digits read aloud in a DIGITS challenge
+	digitCount = 6
+)
+
+var (
+	kinds     = []string{KindHeadTurn, KindBlink, KindDigits}
+	headMoves = []string{ActionTurnLeft, ActionTurnRight, ActionLookUp, ActionLookDown}
+)
+
+// Challenge is a randomized task the user performs in a liveness clip. It is
+// kept server side; the clip passes only if it shows this exact task, which
+// makes a recording made for an earlier challenge useless.
+type Challenge struct {
+	ID        string
+	SessionID string
+	Kind      string
+	Actions   []string
+	Digits    string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	// ArtifactID is the clip that answered the challenge, empty while open
+	ArtifactID string
+}
+
+// Generate draws a random challenge for a session from crypto/rand
+func Generate(sessionID string, now time.Time, ttl time.Duration) (*Challenge, error) {
+	c := &Challenge{
+		SessionID: sessionID,
+		IssuedAt:  now.UTC(),
+		ExpiresAt: now.UTC().Add(ttl),
+		Actions:   []string{},
+	}
+
+	kind, err := pick(kinds)
+	if err != nil {
+		return nil, err
+	}
+	c.Kind = kind
+
+	switch kind {
+	case KindHeadTurn:
+		// Consecutive moves differ so each one is a visible change
+		for len(c.Actions) < headTurns {
+			move, err := pick(headMoves)
+			if err != nil {
+				return nil, err
+			}
+			if len(c.Actions) > 0 && c.Actions[len(c.Actions)-1] == move {
+				continue
+			}
+			c.Actions = append(c.Actions, move)
+		}
+	case KindBlink:
+		n, err := randomInt(maxBlinks - minBlinks + 1)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < minBlinks+n; i++ {
+			c.Actions = append(c.Actions, ActionBlink)
+		}
+	case KindDigits:
+		var digits strings.Builder
+		for i := 0; i < digitCount; i++ {
+			d, err := randomInt(10)
+			if err != nil {
+				return nil, err
+			}
+			digits.WriteByte(byte('0' + d))
+		}
+		c.Digits = digits.String()
+	}
+
+	return c, nil
+}
+
+// Matches reports whether the observed actions and digits are exactly the
+// ones the challenge asked for
+func (c *Challenge) Matches(actions []string, digits string) bool {
+	if c.Kind == KindDigits {
+		return digits == c.Digits
+	}
+	if len(actions) != len(c.Actions) {
+		return false
+	}
+	for i := range actions {
+		if actions[i] != c.Actions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ToProto converts a challenge into its protobuf message
+func (c *Challenge) ToProto() *proto.LivenessChallenge {
+	return &proto.LivenessChallenge{
+		ChallengeId: c.ID,
+		SessionId:   c.SessionID,
+		Kind:        proto.LivenessChallengeKind(proto.LivenessChallengeKind_value["LIVENESS_CHALLENGE_"+c.Kind]),
+		Actions:     c.Actions,
+		Digits:      c.Digits,
+		IssuedAt:    timestamppb.New(c.IssuedAt),
+		ExpiresAt:   timestamppb.New(c.ExpiresAt),
+	}
+}
+
+// pick returns a uniformly random element of options
+func pick(options []string) (string, error) {
+	i, err := randomInt(len(options))
+	if err != nil {
+		return "", err
+	}
+	return options[i], nil
+}
+
+// randomInt returns a uniformly random integer in [0, n)
+func randomInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to draw challenge: %w", err)
+	}
+	return int(v.Int64()), nil
+}
@@ -0,0 +1,106 @@
+package challenge
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestGenerate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 19, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+	ttl := 5 * time.Minute
+	seen := map[string]bool{}
+
+	// Draw enough challenges to see every kind
+	for i := 0; i < 300; i++ {
+		c, err := Generate("session-1", now, ttl)
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		seen[c.Kind] = true
+
+		if c.SessionID != "session-1" {
+			t.Errorf("SessionID = %q, want session-1", c.SessionID)
+		}
+		if !c.IssuedAt.Equal(now) || c.IssuedAt.Location() != time.UTC {
+			t.Errorf("IssuedAt = %v, want %v in UTC", c.IssuedAt, now)
+		}
+		if got := c.ExpiresAt.Sub(c.IssuedAt); got != ttl {
+			t.Errorf("ExpiresAt - IssuedAt = %v, want %v", got, ttl)
+		}
+
+		switch c.Kind {
+		case KindHeadTurn:
+			if len(c.Actions) != headTurns || c.Digits != "" {
+				t.Fatalf("HEAD_TURN challenge = %v %q, want %d moves and no digits", c.Actions, c.Digits, headTurns)
+			}
+			for j, move := range c.Actions {
+				if !slices.Contains(headMoves, move) {
+					t.Errorf("HEAD_TURN action %q is not a head move", move)
+				}
+				if j > 0 && move == c.Actions[j-1] {
+					t.Errorf("HEAD_TURN actions %v repeat %s consecutively", c.Actions, move)
+				}
+			}
+		case KindBlink:
+			if len(c.Actions) < minBlinks || len(c.Actions) > maxBlinks || c.Digits != "" {
+				t.Fatalf("BLINK challenge = %v %q, want %d to %d blinks and no digits", c.Actions, c.Digits, minBlinks, maxBlinks)
+			}
+			for _, action := range c.Actions {
+				if action != ActionBlink {
+					t.Errorf("BLINK action = %q, want %s", action, ActionBlink)
+				}
+			}
+		case KindDigits:
+			if len(c.Digits) != digitCount || len(c.Actions) != 0 {
+				t.Fatalf("DIGITS challenge = %v %q, want %d digits and no actions", c.Actions, c.Digits, digitCount)
+			}
+			for _, d := range c.Digits {
+				if d < '0' || d > '9' {
+					t.Errorf("DIGITS challenge %q contains %q", c.Digits, d)
+				}
+			}
+		default:
+			t.Fatalf("Kind = %q, want one of %v", c.Kind, kinds)
+		}
+	}
+
+	for _, kind := range kinds {
+		if !seen[kind] {
+			t.Errorf("no %s challenge in 300 draws", kind)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	headTurn := &Challenge{Kind: KindHeadTurn, Actions: []string{ActionTurnLeft, ActionLookUp, ActionTurnRight}}
+	digits := &Challenge{Kind: KindDigits, Actions: []string{}, Digits: "042917"}
+
+	tests := []struct {
+		name      string
+		challenge *Challenge
+		actions   []string
+		digits    string
+		want      bool
+	}{
+		{name: "same moves", challenge: headTurn, actions: []string{ActionTurnLeft, ActionLookUp, ActionTurnRight}, want: true},
+		{name: "moves out of order", challenge: headTurn, actions: []string{ActionLookUp, ActionTurnLeft, ActionTurnRight}},
+		{name: "missing move", challenge: headTurn, actions: []string{ActionTurnLeft, ActionLookUp}},
+		{name: "extra move", challenge: headTurn, actions: []string{ActionTurnLeft, ActionLookUp, ActionTurnRight, ActionLookDown}},
+		{name: "no moves", challenge: headTurn},
+		{name: "moves ignore digits", challenge: headTurn, actions: []string{ActionTurnLeft, ActionLookUp, ActionTurnRight}, digits: "123456", want: true},
+		{name: "same digits", challenge: digits, digits: "042917", want: true},
+		{name: "other digits", challenge: digits, digits: "042918"},
+		{name: "digits without leading zero", challenge: digits, digits: "42917"},
+		{name: "no digits", challenge: digits},
+		{name: "digits ignore actions", challenge: digits, actions: []string{ActionBlink}, digits: "042917", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.challenge.Matches(tt.actions, tt.digits); got != tt.want {
+				t.Errorf("Matches(%v, %q) = %v, want %v", tt.actions, tt.digits, got, tt.want)
+			}
+		})
+	}
+}
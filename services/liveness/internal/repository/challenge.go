@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+	"github.com/jackc/pgx/v5"
+)
+
+const challengeColumns = `id::text, session_id::text, kind, actions, digits, issued_at, expires_at, COALESCE(artifact_id::text, '')`
+
+// ChallengeRepository persists liveness challenges
+type ChallengeRepository struct {
+	db *db.DB
+}
+
+// NewChallengeRepository creates a new challenge repository
+func NewChallengeRepository(database *db.DB) *ChallengeRepository {
+	return &ChallengeRepository{db: database}
+}
+
+// Issue stores candidate as the session's challenge unless the session
+// already has an open one, which is returned instead. The session row is
+// locked so concurrent requests agree on a single open challenge.
+func (r *ChallengeRepository) Issue(ctx context.Context, candidate *challenge.Challenge) (*challenge.Challenge, error) {
+	var issued *challenge.Challenge
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var locked string
+		err := tx.QueryRow(ctx, `SELECT id::text FROM ekyc_sessions WHERE id = $1 FOR UPDATE`, candidate.SessionID).Scan(&locked)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrRecordNotFound
+			}
+			return fmt.Errorf("failed to lock session: %w", err)
+		}
+
+		issued, err = scanChallenge(tx.QueryRow(ctx, `
+			SELECT `+challengeColumns+`
+			FROM liveness_challenges
+			WHERE session_id = $1 AND artifact_id IS NULL AND expires_at > $2
+			ORDER BY issued_at DESC
+			LIMIT 1`,
+			candidate.SessionID, candidate.IssuedAt,
+		))
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to look up open challenge: %w", err)
+		}
+
+		issued, err = scanChallenge(tx.QueryRow(ctx, `
+			INSERT INTO liveness_challenges (session_id, kind, actions, digits, issued_at, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING `+challengeColumns,
+			candidate.SessionID, candidate.Kind, candidate.Actions, candidate.Digits, candidate.IssuedAt, candidate.ExpiresAt,
+		))
+		if err != nil {
+			return fmt.Errorf("failed to insert challenge: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+// Answer binds a clip to the most recent challenge issued before it was
+// uploaded and returns that challenge. A challenge answers at most one clip:
+// if another clip already claimed it, the challenge is returned unchanged
+// with that clip's ArtifactID, while a redelivered upload of the same clip
+// gets the same challenge back. It returns apperrors.ErrRecordNotFound when
+// no challenge was issued before the upload.
+func (r *ChallengeRepository) Answer(ctx context.Context, sessionID, artifactID string, uploadedAt time.Time) (*challenge.Challenge, error) {
+	var answered *challenge.Challenge
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		c, err := scanChallenge(tx.QueryRow(ctx, `
+			SELECT `+challengeColumns+`
+			FROM liveness_challenges
+			WHERE session_id = $1 AND issued_at <= $2
+			ORDER BY issued_at DESC
+			LIMIT 1
+			FOR UPDATE`,
+			sessionID, uploadedAt,
+		))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrRecordNotFound
+			}
+			return fmt.Errorf("failed to look up challenge: %w", err)
+		}
+
+		if c.ArtifactID == "" {
+			_, err = tx.Exec(ctx, `
+				UPDATE liveness_challenges
+				SET artifact_id = $2, answered_at = NOW()
+				WHERE id = $1`,
+				c.ID, artifactID,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to answer challenge: %w", err)
+			}
+			c.ArtifactID = artifactID
+		}
+
+		answered = c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return answered, nil
+}
+
+// scanChallenge scans a row selected with challengeColumns
+func scanChallenge(row pgx.Row) (*challenge.Challenge, error) {
+	var c challenge.Challenge
+	if err := row.Scan(&c.ID, &c.SessionID, &c.Kind, &c.Actions, &c.Digits, &c.IssuedAt, &c.ExpiresAt, &c.ArtifactID); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// StatusLivenessPending is the session status in which challenges are issued
+const StatusLivenessPending = "LIVENESS_PENDING"
+
+// Session is the part of an eKYC session the liveness service needs
+type Session struct {
+	ID     string
+	UserID string
+	Status string
+}
+
+// SessionRepository reads the sessions owned by the identity service
+type SessionRepository struct {
+	db *db.DB
+}
+
+// NewSessionRepository creates a new session repository
+func NewSessionRepository(database *db.DB) *SessionRepository {
+	return &SessionRepository{db: database}
+}
+
+// Get loads a session by ID, or returns apperrors.ErrRecordNotFound
+func (r *SessionRepository) Get(ctx context.Context, sessionID string) (*Session, error) {
+	s := &Session{ID: sessionID}
+
+	err := r.db.QueryRow(ctx, `
+		SELECT COALESCE(user_id::text, ''), status::text
+		FROM ekyc_sessions
+		WHERE id = $1`,
+		sessionID,
+	).Scan(&s.UserID, &s.Status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return s, nil
+}
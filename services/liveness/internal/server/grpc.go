@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+	"github.com/ekyc-backend/services/liveness/internal/repository"
+	"github.com/ekyc-backend/services/liveness/internal/worker"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// LivenessServer implements the LivenessService gRPC API
+type LivenessServer struct {
+	proto.UnimplementedLivenessServiceServer
+
+	policy     worker.Policy
+	sessions   *repository.SessionRepository
+	challenges *repository.ChallengeRepository
+	logger     *logger.Logger
+}
+
+// NewLivenessServer creates a new liveness gRPC server
+func NewLivenessServer(policy worker.Policy, sessions *repository.SessionRepository, challenges *repository.ChallengeRepository, logger *logger.Logger) *LivenessServer {
+	return &LivenessServer{
+		policy:     policy,
+		sessions:   sessions,
+		challenges: challenges,
+		logger:     logger,
+	}
+}
+
+// GetChallenge returns the open challenge of a session waiting for liveness.
+// A challenge is normally issued when the session enters LIVENESS_PENDING;
+// if that one has expired or was answered by a failed clip, a new one is
+// issued here so the user can retry.
+func (s *LivenessServer) GetChallenge(ctx context.Context, req *proto.GetLivenessChallengeRequest) (*proto.LivenessChallenge, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+	if !s.policy.Active() {
+		return nil, status.Error(codes.FailedPrecondition, "active liveness challenges are disabled")
+	}
+
+	current, err := s.sessions.Get(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+	if err := authorizeUser(ctx, current.UserID); err != nil {
+		return nil, err
+	}
+	if current.Status != repository.StatusLivenessPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "session is %s, not waiting for liveness", current.Status)
+	}
+
+	candidate, err := challenge.Generate(current.ID, time.Now(), s.policy.ChallengeTTL)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+	issued, err := s.challenges.Issue(ctx, candidate)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	return issued.ToProto(), nil
+}
+
+// authorizeUser allows a call on behalf of userID only for that user or an admin.
+// Calls without token claims have already been vetted by the auth policy.
+func authorizeUser(ctx context.Context, userID string) error {
+	claims, ok := grpcmw.ClaimsFromContext(ctx)
+	if !ok || claims.UserID == userID || claims.HasRole("ADMIN") {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "session does not belong to the caller")
+}
+
+// toStatusError maps repository errors to gRPC status errors
+func (s *LivenessServer) toStatusError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, apperrors.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "session not found")
+	default:
+		s.logger.WithContext(ctx).Error("Liveness request failed", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/liveness/internal/analyzer"
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+)
+
+// Modes accepted in LIVENESS_MODE
+const (
+	// ModeActive checks a liveness clip against a server-issued challenge
+	ModeActive = "active"
+	// ModePassive checks the selfie on its own
+	ModePassive = "passive"
+)
+
+// Policy decides how sessions are checked for liveness
+type Policy struct {
+	Mode string
+	// ChallengeTTL is how long a challenge can be answered after it is issued
+	ChallengeTTL time.Duration
+	// Threshold is the lowest analyzer score accepted as live
+	Threshold float32
+	// ForceFail fails every check, to exercise the rejection path
+	ForceFail bool
+}
+
+// PolicyFromConfig reads and validates the liveness policy
+func PolicyFromConfig(cfg *config.Config) (Policy, error) {
+	p := Policy{
+		Mode:         cfg.LivenessMode,
+		ChallengeTTL: cfg.LivenessChallengeTTL,
+		Threshold:    float32(cfg.LivenessThreshold),
+		ForceFail:    cfg.LivenessForceFail,
+	}
+
+	if p.Mode != ModeActive && p.Mode != ModePassive {
+		return Policy{}, fmt.Errorf("unsupported liveness mode %q", p.Mode)
+	}
+	if p.ChallengeTTL <= 0 {
+		return Policy{}, fmt.Errorf("liveness challenge TTL must be positive")
+	}
+	if p.Threshold < 0 || p.Threshold > 1 {
+		return Policy{}, fmt.Errorf("liveness threshold %v outside [0, 1]", p.Threshold)
+	}
+
+	return p, nil
+}
+
+// Active reports whether sessions answer a challenge with a liveness clip
+func (p Policy) Active() bool {
+	return p.Mode == ModeActive
+}
+
+// answerReasons checks that a clip uploaded at uploadedAt answers c, the
+// session's latest challenge issued before the upload or nil if there is
+// none: it must be the clip recorded as the answer and arrive before the
+// challenge expires
+func answerReasons(c *challenge.Challenge, artifactID string, uploadedAt time.Time) []reasons.Reason {
+	switch {
+	case c == nil:
+		return []reasons.Reason{reasons.New(reasons.LivenessChallengeMissing, "", "no challenge was issued before the clip was uploaded")}
+	case c.ArtifactID != artifactID:
+		return []reasons.Reason{reasons.New(reasons.LivenessChallengeMissing, "", "the challenge was already answered by another clip")}
+	case uploadedAt.After(c.ExpiresAt):
+		return []reasons.Reason{reasons.New(reasons.LivenessChallengeExpired, "", "the clip was uploaded after the challenge expired")}
+	default:
+		return nil
+	}
+}
+
+// matchReasons checks that the analyzer saw the challenged actions or digits
+// in a clip answering c
+func matchReasons(c *challenge.Challenge, analysis *analyzer.Analysis) []reasons.Reason {
+	if c.Matches(analysis.Actions, analysis.Digits) {
+		return nil
+	}
+	return []reasons.Reason{reasons.New(reasons.LivenessChallengeMismatch, "", "the clip does not show the "+strings.ToLower(c.Kind)+" challenge")}
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/liveness/internal/analyzer"
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+)
+
+func TestAnswerReasons(t *testing.T) {
+	issuedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := issuedAt.Add(5 * time.Minute)
+	answered := func(artifactID string) *challenge.Challenge {
+		return &challenge.Challenge{ID: "challenge-1", Kind: challenge.KindBlink, IssuedAt: issuedAt, ExpiresAt: expiresAt, ArtifactID: artifactID}
+	}
+
+	tests := []struct {
+		name       string
+		challenge  *challenge.Challenge
+		uploadedAt time.Time
+		want       []string
+	}{
+		{name: "answered in time", challenge: answered("clip-1"), uploadedAt: expiresAt.Add(-time.Second)},
+		{name: "answered as it expires", challenge: answered("clip-1"), uploadedAt: expiresAt},
+		{name: "no challenge issued", uploadedAt: issuedAt, want: []string{reasons.LivenessChallengeMissing}},
+		{name: "answered by another clip", challenge: answered("clip-0"), uploadedAt: issuedAt.Add(time.Minute), want: []string{reasons.LivenessChallengeMissing}},
+		{name: "expired", challenge: answered("clip-1"), uploadedAt: expiresAt.Add(time.Second), want: []string{reasons.LivenessChallengeExpired}},
+		{name: "expired and answered by another clip", challenge: answered("clip-0"), uploadedAt: expiresAt.Add(time.Hour), want: []string{reasons.LivenessChallengeMissing}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reasons.Codes(answerReasons(tt.challenge, "clip-1", tt.uploadedAt))
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("answerReasons(%s) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchReasons(t *testing.T) {
+	headTurn := &challenge.Challenge{Kind: challenge.KindHeadTurn, Actions: []string{challenge.ActionTurnLeft, challenge.ActionLookDown, challenge.ActionTurnRight}}
+	digits := &challenge.Challenge{Kind: challenge.KindDigits, Actions: []string{}, Digits: "731045"}
+
+	tests := []struct {
+		name      string
+		challenge *challenge.Challenge
+		analysis  *analyzer.Analysis
+		want      []string
+	}{
+		{name: "moves shown", challenge: headTurn, analysis: &analyzer.Analysis{Actions: []string{challenge.ActionTurnLeft, challenge.ActionLookDown, challenge.ActionTurnRight}}},
+		{name: "other moves", challenge: headTurn, analysis: &analyzer.Analysis{Actions: []string{challenge.ActionTurnRight, challenge.ActionLookDown, challenge.ActionTurnLeft}}, want: []string{reasons.LivenessChallengeMismatch}},
+		{name: "digits read", challenge: digits, analysis: &analyzer.Analysis{Digits: "731045"}},
+		{name: "other digits", challenge: digits, analysis: &analyzer.Analysis{Digits: "731046"}, want: []string{reasons.LivenessChallengeMismatch}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reasons.Codes(matchReasons(tt.challenge, tt.analysis))
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("matchReasons(%s) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/liveness/internal/analyzer"
+	"github.com/ekyc-backend/services/liveness/internal/challenge"
+	"github.com/ekyc-backend/services/liveness/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	// MaxClipSize bounds how much of a liveness clip is read, matching the
+	// largest clip the storage service accepts
+	MaxClipSize = 50 << 20
+	// MaxFrameSize bounds how much of a selfie is read
+	MaxFrameSize = 10 << 20
+)
+
+var (
+	clipType   = proto.ArtifactType_LIVENESS_CLIP.String()
+	selfieType = proto.ArtifactType_SELFIE.String()
+)
+
+// Worker issues liveness challenges and checks uploads against them. In
+// active mode a challenge is issued when a session starts waiting for
+// liveness and the clip uploaded next must perform it; in passive mode the
+// selfie is analyzed on its own.
+type Worker struct {
+	policy     Policy
+	analyzer   analyzer.Analyzer
+	objects    *storage.EncryptedStore
+	challenges *repository.ChallengeRepository
+	results    *repository.ResultRepository
+	bus        events.EventBus
+	logger     *logger.Logger
+}
+
+// NewWorker creates a new liveness worker
+func NewWorker(policy Policy, liveness analyzer.Analyzer, objects *storage.EncryptedStore, challenges *repository.ChallengeRepository, results *repository.ResultRepository, bus events.EventBus, logger *logger.Logger) *Worker {
+	return &Worker{
+		policy:     policy,
+		analyzer:   liveness,
+		objects:    objects,
+		challenges: challenges,
+		results:    results,
+		bus:        bus,
+		logger:     logger,
+	}
+}
+
+// resultPayload is the shape stored in ekyc_results.payload_json
+type resultPayload struct {
+	Mode        string           `json:"mode"`
+	Analyzer    string           `json:"analyzer"`
+	ChallengeID string           `json:"challenge_id,omitempty"`
+	Reasons     []reasons.Reason `json:"reasons"`
+	Liveness    json.RawMessage  `json:"liveness"`
+}
+
+// HandleSessionStatusChanged issues a challenge once a session waits for
+// liveness. A session keeps an open challenge until it expires, so a
+// redelivered event does not replace the one the user may be performing.
+func (w *Worker) HandleSessionStatusChanged(ctx context.Context, event *proto.SessionStatusChanged) error {
+	if !w.policy.Active() || event.GetStatus() != repository.StatusLivenessPending {
+		return nil
+	}
+
+	candidate, err := challenge.Generate(event.GetSessionId(), time.Now(), w.policy.ChallengeTTL)
+	if err != nil {
+		return err
+	}
+	issued, err := w.challenges.Issue(ctx, candidate)
+	if errors.Is(err, apperrors.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.logger.WithContext(ctx).WithSessionID(event.GetSessionId()).Info("Liveness challenge issued",
+		zap.String("challenge_id", issued.ID),
+		zap.String("kind", issued.Kind),
+		zap.Time("expires_at", issued.ExpiresAt),
+	)
+
+	return nil
+}
+
+// HandleArtifactUploaded checks liveness clips in active mode and selfies in
+// passive mode. Rechecking a redelivered upload replaces its result.
+func (w *Worker) HandleArtifactUploaded(ctx context.Context, event *proto.ArtifactUploaded) error {
+	switch {
+	case w.policy.Active() && event.GetArtifactType() == clipType:
+		return w.checkClip(ctx, event)
+	case !w.policy.Active() && event.GetArtifactType() == selfieType:
+		return w.checkSelfie(ctx, event)
+	default:
+		return nil
+	}
+}
+
+// checkClip verifies that a clip answers the session's challenge: it must be
+// uploaded while the challenge is open, be the first clip to answer it and
+// show the challenged actions or digits. The analyzer only runs once the
+// challenge checks pass.
+func (w *Worker) checkClip(ctx context.Context, event *proto.ArtifactUploaded) error {
+	result := &proto.LivenessResult{LivenessType: strings.ToUpper(ModeActive)}
+
+	c, err := w.challenges.Answer(ctx, event.GetSessionId(), event.GetArtifactId(), event.GetUploadedAt().AsTime())
+	if err != nil && !errors.Is(err, apperrors.ErrRecordNotFound) {
+		return err
+	}
+	found := answerReasons(c, event.GetArtifactId(), event.GetUploadedAt().AsTime())
+
+	if len(found) == 0 {
+		clip, err := w.fetch(ctx, event, MaxClipSize)
+		if err != nil {
+			return err
+		}
+		analysis, err := w.analyzer.Active(ctx, clip, c)
+		if err != nil {
+			return fmt.Errorf("failed to analyze %s: %w", event.GetObjectKey(), err)
+		}
+		found = append(found, matchReasons(c, analysis)...)
+		found = append(found, w.score(result, analysis)...)
+	}
+
+	challengeID := ""
+	if c != nil {
+		challengeID = c.ID
+	}
+	return w.complete(ctx, event, challengeID, result, found)
+}
+
+// checkSelfie analyzes a selfie for presentation attacks
+func (w *Worker) checkSelfie(ctx context.Context, event *proto.ArtifactUploaded) error {
+	result := &proto.LivenessResult{LivenessType: strings.ToUpper(ModePassive)}
+
+	frame, err := w.fetch(ctx, event, MaxFrameSize)
+	if err != nil {
+		return err
+	}
+	analysis, err := w.analyzer.Passive(ctx, frame)
+	if err != nil {
+		return fmt.Errorf("failed to analyze %s: %w", event.GetObjectKey(), err)
+	}
+
+	return w.complete(ctx, event, "", result, w.score(result, analysis))
+}
+
+// score records an analysis on the result and checks it against the threshold
+func (w *Worker) score(result *proto.LivenessResult, analysis *analyzer.Analysis) []reasons.Reason {
+	result.Confidence = analysis.Score
+	result.Metrics = map[string]float32{"score": analysis.Score}
+	for name, value := range analysis.Metrics {
+		result.Metrics[name] = value
+	}
+
+	if analysis.Score < w.policy.Threshold {
+		return []reasons.Reason{reasons.New(reasons.LivenessSpoof, "", "liveness score is below the threshold")}
+	}
+	return nil
+}
+
+// complete decides the outcome, stores the result against the artifact and
+// publishes liveness.completed
+func (w *Worker) complete(ctx context.Context, event *proto.ArtifactUploaded, challengeID string, result *proto.LivenessResult, found []reasons.Reason) error {
+	if w.policy.ForceFail {
+		found = append(found, reasons.New(reasons.LivenessSpoof, "", "liveness failure forced by LIVENESS_FORCE_FAIL"))
+	}
+	result.Passed = len(found) == 0
+
+	livenessJSON, err := protojson.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal liveness result: %w", err)
+	}
+
+	encoded, err := json.Marshal(resultPayload{
+		Mode:        w.policy.Mode,
+		Analyzer:    w.analyzer.Name(),
+		ChallengeID: challengeID,
+		Reasons:     found,
+		Liveness:    livenessJSON,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal result payload: %w", err)
+	}
+
+	saved, err := w.results.Save(ctx, &repository.Result{
+		SessionID:  event.GetSessionId(),
+		ArtifactID: event.GetArtifactId(),
+		Kind:       repository.KindLiveness,
+		Payload:    encoded,
+		Quality:    result.Confidence,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = events.Publish(events.WithSessionID(ctx, event.GetSessionId()), w.bus, &proto.LivenessCompleted{
+		ResultId:    saved.ID,
+		SessionId:   event.GetSessionId(),
+		ArtifactId:  event.GetArtifactId(),
+		Mode:        w.policy.Mode,
+		ChallengeId: challengeID,
+		Passed:      result.Passed,
+		Confidence:  result.Confidence,
+		Reasons:     events.ProtoReasons(found),
+		CompletedAt: timestamppb.New(saved.CreatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish %s: %w", events.SubjectLivenessCompleted, err)
+	}
+
+	w.logger.WithContext(ctx).WithSessionID(event.GetSessionId()).Info("Liveness check completed",
+		zap.String("artifact_id", event.GetArtifactId()),
+		zap.String("mode", w.policy.Mode),
+		zap.String("analyzer", w.analyzer.Name()),
+		zap.String("challenge_id", challengeID),
+		zap.Float32("confidence", result.Confidence),
+		zap.Bool("passed", result.Passed),
+		zap.Strings("reasons", reasons.Codes(found)),
+	)
+
+	return nil
+}
+
+// fetch reads an uploaded clip or frame, decrypting it if needed
+func (w *Worker) fetch(ctx context.Context, event *proto.ArtifactUploaded, limit int64) (analyzer.Media, error) {
+	object, err := w.objects.GetFile(ctx, event.GetObjectKey())
+	if err != nil {
+		return analyzer.Media{}, err
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(io.LimitReader(object, limit+1))
+	if err != nil {
+		return analyzer.Media{}, fmt.Errorf("failed to read %s: %w", event.GetObjectKey(), err)
+	}
+	if int64(len(data)) > limit {
+		return analyzer.Media{}, fmt.Errorf("object %s exceeds %d bytes", event.GetObjectKey(), limit)
+	}
+
+	return analyzer.Media{Data: data, ContentType: event.GetContentType()}, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/liveness/internal/analyzer"
+	"github.com/ekyc-backend/services/liveness/internal/repository"
+	"github.com/ekyc-backend/services/liveness/internal/server"
+	"github.com/ekyc-backend/services/liveness/internal/worker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Liveness service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize encryption to read encrypted artifacts
+	kms, err := crypto.LoadKMS(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to load KMS", zap.Error(err))
+	}
+
+	// Initialize object storage
+	minioClient, err := storage.NewMinIO(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to MinIO", zap.Error(err))
+	}
+	objects := storage.NewEncryptedStore(minioClient, kms, storage.NewDataKeys(database), log)
+
+	// Initialize the liveness policy and analyzer
+	policy, err := worker.PolicyFromConfig(cfg)
+	if err != nil {
+		log.Fatal("Failed to read liveness policy", zap.Error(err))
+	}
+
+	livenessAnalyzer, err := analyzer.New(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize liveness analyzer", zap.Error(err))
+	}
+	defer livenessAnalyzer.Close()
+	log.Info("Liveness analyzer ready",
+		zap.String("analyzer", livenessAnalyzer.Name()),
+		zap.String("mode", policy.Mode),
+	)
+
+	// Initialize event bus
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// Issue challenges when sessions wait for liveness and check the uploads
+	sessions := repository.NewSessionRepository(database)
+	challenges := repository.NewChallengeRepository(database)
+	livenessWorker := worker.NewWorker(policy, livenessAnalyzer, objects, challenges, repository.NewResultRepository(database), bus, log)
+	retry := events.WithRetryPolicy(events.RetryPolicy{
+		MaxAttempts:  cfg.LivenessMaxAttempts,
+		InitialDelay: cfg.LivenessRetryDelay,
+		MaxDelay:     cfg.LivenessMaxRetryDelay,
+	})
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, livenessWorker.HandleSessionStatusChanged, retry); err != nil {
+		log.Fatal("Failed to subscribe to session status changes", zap.Error(err))
+	}
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, livenessWorker.HandleArtifactUploaded, retry); err != nil {
+		log.Fatal("Failed to subscribe to artifact uploads", zap.Error(err))
+	}
+
+	// Initialize Redis for the token denylist written by the gateway
+	redisClient, err := storage.NewRedis(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to Redis", zap.Error(err))
+	}
+	defer redisClient.Close()
+
+	// Initialize token verification against the gateway's published keys and
+	// revocations
+	verifier := jwtkeys.NewVerifier(jwtkeys.NewRemoteKeySet(cfg.JWKSURL, jwtkeys.DefaultJWKSRefreshInterval), cfg.JWTIssuer)
+	denylist := jwtkeys.NewDenylist(redisClient)
+	authPolicy := grpcmw.NewAuthPolicy().
+		RequireAnyRole("/ekyc.LivenessService/GetChallenge", "USER", "ADMIN")
+
+	// Initialize mTLS for service-to-service traffic
+	tlsSource, err := mtls.Load(mtls.OptionsFromConfig(cfg), log)
+	if err != nil {
+		log.Fatal("Failed to load mTLS configuration", zap.Error(err))
+	}
+	if tlsSource != nil {
+		defer tlsSource.Close()
+	}
+
+	// Only the gateway calls this service
+	peers := mtls.NewPeerPolicy(cfg.TLSTrustDomain).Allow("api-gateway")
+
+	// Initialize gRPC server
+	serverOptions := append(mtls.ServerOptions(tlsSource, peers),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryTracingInterceptor(cfg.ServiceName),
+			grpcmw.UnaryLoggingInterceptor(log),
+			grpcmw.UnaryAuthInterceptor(verifier, denylist, authPolicy, log),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamTracingInterceptor(cfg.ServiceName),
+			grpcmw.StreamLoggingInterceptor(log),
+			grpcmw.StreamAuthInterceptor(verifier, denylist, authPolicy, log),
+		),
+	)
+	grpcServer := grpc.NewServer(serverOptions...)
+	proto.RegisterLivenessServiceServer(grpcServer, server.NewLivenessServer(policy, sessions, challenges, log))
+
+	listener, err := net.Listen("tcp", cfg.GetGRPCAddr())
+	if err != nil {
+		log.Fatal("Failed to listen for gRPC", zap.Error(err))
+	}
+
+	go func() {
+		log.Info("Starting gRPC server", zap.String("addr", cfg.GetGRPCAddr()))
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	// Initialize HTTP server for health checks
+	httpServer := server.NewHTTPServer(cfg)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcServer.GracefulStop()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}
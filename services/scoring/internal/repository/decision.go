@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/services/scoring/internal/rules"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// foreignKeyViolation is the PostgreSQL error code for a missing referenced row
+const foreignKeyViolation = "23503"
+
+// Decision is a persisted automated decision
+type Decision struct {
+	ID        string
+	SessionID string
+	// Outcome is the outcome recorded for the session, which for a replayed
+	// decision is the one stored first rather than the one just evaluated
+	Outcome   *rules.Outcome
+	CreatedAt time.Time
+	// Replayed is set when the session had already been scored with the
+	// same ruleset digest and no new decision was recorded
+	Replayed bool
+}
+
+// DecisionRepository persists scoring decisions in ekyc_decisions
+type DecisionRepository struct {
+	db *db.DB
+}
+
+// NewDecisionRepository creates a new decision repository
+func NewDecisionRepository(database *db.DB) *DecisionRepository {
+	return &DecisionRepository{db: database}
+}
+
+// Save records the outcome of a ruleset for a session together with the
+// ruleset version and digest, and writes the scored event to the outbox in
+// the same transaction. A session is scored once per ruleset digest: if a
+// decision already exists for it, that decision is returned as replayed and
+// no event is written. It returns apperrors.ErrRecordNotFound if the session
+// does not exist.
+func (r *DecisionRepository) Save(ctx context.Context, sessionID string, outcome *rules.Outcome, decidedBy string) (*Decision, error) {
+	reasons, err := json.Marshal(decisionReasons{Reasons: outcome.Reasons})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal decision reasons: %w", err)
+	}
+
+	d := &Decision{SessionID: sessionID, Outcome: outcome}
+	err = withTx(ctx, r.db, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO ekyc_decisions (session_id, status, score, reasons_json, decided_by, ruleset_version, ruleset_digest)
+			VALUES ($1, $2::decision_status, $3, $4, $5, $6, $7)
+			ON CONFLICT (session_id, ruleset_digest) DO NOTHING
+			RETURNING id::text, created_at`,
+			sessionID, outcome.Decision, outcome.Score, reasons, decidedBy, outcome.Version, outcome.Digest,
+		).Scan(&d.ID, &d.CreatedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return loadExisting(ctx, tx, d, outcome.Digest)
+		}
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+				return apperrors.ErrRecordNotFound
+			}
+			return fmt.Errorf("failed to insert decision: %w", err)
+		}
+
+		return events.Enqueue(events.WithSessionID(ctx, sessionID), tx, &proto.SessionScored{
+			DecisionId:     d.ID,
+			SessionId:      sessionID,
+			Decision:       outcome.Decision,
+			Score:          int32(outcome.Score),
+			Reasons:        outcome.Codes(),
+			RulesetVersion: outcome.Version,
+			RulesetDigest:  outcome.Digest,
+			ScoredAt:       timestamppb.New(d.CreatedAt),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// decisionReasons is the shape of reasons_json
+type decisionReasons struct {
+	Reasons []rules.Reason `json:"reasons"`
+}
+
+// loadExisting fills d with the decision already recorded for its session
+// under digest and marks it as replayed
+func loadExisting(ctx context.Context, tx pgx.Tx, d *Decision, digest string) error {
+	var (
+		outcome = &rules.Outcome{Digest: digest}
+		version *string
+		reasons []byte
+	)
+	err := tx.QueryRow(ctx, `
+		SELECT id::text, status::text, score, reasons_json, ruleset_version, created_at
+		FROM ekyc_decisions
+		WHERE session_id = $1 AND ruleset_digest = $2`,
+		d.SessionID, digest,
+	).Scan(&d.ID, &outcome.Decision, &outcome.Score, &reasons, &version, &d.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to load existing decision: %w", err)
+	}
+
+	if len(reasons) > 0 {
+		var stored decisionReasons
+		if err := json.Unmarshal(reasons, &stored); err != nil {
+			return fmt.Errorf("failed to unmarshal decision reasons: %w", err)
+		}
+		outcome.Reasons = stored.Reasons
+	}
+	if version != nil {
+		outcome.Version = *version
+	}
+
+	d.Outcome = outcome
+	d.Replayed = true
+	return nil
+}
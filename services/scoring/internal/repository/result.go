@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+)
+
+// Kinds of results stored in ekyc_results by the check services
+const (
+	KindOCR      = "OCR"
+	KindFace     = "FACE"
+	KindLiveness = "LIVENESS"
+)
+
+// Result is a check result stored for one artifact of a session
+type Result struct {
+	ID        string
+	Kind      string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// ResultRepository reads the results the check services store in ekyc_results
+type ResultRepository struct {
+	db *db.DB
+}
+
+// NewResultRepository creates a new result repository
+func NewResultRepository(database *db.DB) *ResultRepository {
+	return &ResultRepository{db: database}
+}
+
+// ListBySession returns the results of a session, oldest first
+func (r *ResultRepository) ListBySession(ctx context.Context, sessionID string) ([]*Result, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id::text, kind::text, COALESCE(payload_json, '{}'::jsonb), created_at
+		FROM ekyc_results
+		WHERE session_id = $1
+		ORDER BY created_at, id`,
+		sessionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*Result
+	for rows.Next() {
+		var result Result
+		if err := rows.Scan(&result.ID, &result.Kind, &result.Payload, &result.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan result: %w", err)
+		}
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read results: %w", err)
+	}
+
+	return results, nil
+}
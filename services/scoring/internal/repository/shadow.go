@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/services/scoring/internal/rules"
+	"github.com/jackc/pgx/v5"
+)
+
+// RulesetRates counts the outcomes of one ruleset over the compared decisions
+type RulesetRates struct {
+	Version  string
+	Digest   string
+	Active   bool
+	Total    int
+	Approved int
+	Review   int
+	Rejected int
+	AvgScore float64
+	// Flipped counts decisions a candidate would have decided differently
+	Flipped int
+}
+
+// RulesetOutcome is the outcome one ruleset gave a decision
+type RulesetOutcome struct {
+	Version  string         `json:"version"`
+	Decision string         `json:"status"`
+	Score    int            `json:"score"`
+	Reasons  []rules.Reason `json:"reasons"`
+}
+
+// Flip is an automated decision on which the rulesets disagree
+type Flip struct {
+	DecisionID string
+	SessionID  string
+	ScoredAt   time.Time
+	Active     RulesetOutcome
+	Candidates []RulesetOutcome
+}
+
+// ShadowRepository persists candidate ruleset outcomes in shadow_decisions
+type ShadowRepository struct {
+	db *db.DB
+}
+
+// NewShadowRepository creates a new shadow decision repository
+func NewShadowRepository(database *db.DB) *ShadowRepository {
+	return &ShadowRepository{db: database}
+}
+
+// Save records candidate outcomes against the decision they were evaluated
+// with, one per ruleset digest. Saving the same decision again replaces the
+// outcomes of rulesets with the same content.
+func (r *ShadowRepository) Save(ctx context.Context, decision *Decision, outcomes []*rules.Outcome) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		for _, outcome := range outcomes {
+			reasons, err := json.Marshal(map[string]interface{}{"reasons": outcome.Reasons})
+			if err != nil {
+				return fmt.Errorf("failed to marshal shadow reasons: %w", err)
+			}
+
+			_, err = tx.Exec(ctx, `
+				INSERT INTO shadow_decisions (decision_id, session_id, ruleset_version, ruleset_digest, status, score, reasons_json)
+				VALUES ($1, $2, $3, $4, $5::decision_status, $6, $7)
+				ON CONFLICT (decision_id, ruleset_digest) DO UPDATE
+				SET ruleset_version = EXCLUDED.ruleset_version,
+				    status = EXCLUDED.status,
+				    score = EXCLUDED.score,
+				    reasons_json = EXCLUDED.reasons_json`,
+				decision.ID, decision.SessionID, outcome.Version, outcome.Digest, outcome.Decision, outcome.Score, reasons,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert shadow decision %s: %w", outcome.Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Rates counts the outcomes of the active and candidate rulesets over the
+// decisions made by decidedBy in [from, to) that have shadow outcomes, so
+// every ruleset is measured on the same sessions
+func (r *ShadowRepository) Rates(ctx context.Context, decidedBy string, from, to time.Time) ([]RulesetRates, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH compared AS (
+			SELECT d.id, d.status, d.score, d.ruleset_version, d.ruleset_digest
+			FROM ekyc_decisions d
+			WHERE d.decided_by = $1 AND d.created_at >= $2 AND d.created_at < $3
+			  AND EXISTS (SELECT 1 FROM shadow_decisions s WHERE s.decision_id = d.id)
+		)
+		SELECT true, ruleset_version, ruleset_digest, count(*),
+		       count(*) FILTER (WHERE status = 'APPROVED'),
+		       count(*) FILTER (WHERE status = 'REVIEW'),
+		       count(*) FILTER (WHERE status = 'REJECTED'),
+		       coalesce(avg(score), 0)::float8, 0
+		FROM compared
+		GROUP BY ruleset_version, ruleset_digest
+		UNION ALL
+		SELECT false, s.ruleset_version, s.ruleset_digest, count(*),
+		       count(*) FILTER (WHERE s.status = 'APPROVED'),
+		       count(*) FILTER (WHERE s.status = 'REVIEW'),
+		       count(*) FILTER (WHERE s.status = 'REJECTED'),
+		       coalesce(avg(s.score), 0)::float8,
+		       count(*) FILTER (WHERE s.status <> c.status)
+		FROM shadow_decisions s
+		JOIN compared c ON c.id = s.decision_id
+		GROUP BY s.ruleset_version, s.ruleset_digest
+		ORDER BY 1 DESC, 2, 3`,
+		decidedBy, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ruleset rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []RulesetRates
+	for rows.Next() {
+		var rr RulesetRates
+		if err := rows.Scan(&rr.Active, &rr.Version, &rr.Digest, &rr.Total, &rr.Approved, &rr.Review, &rr.Rejected, &rr.AvgScore, &rr.Flipped); err != nil {
+			return nil, fmt.Errorf("failed to scan ruleset rates: %w", err)
+		}
+		rates = append(rates, rr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ruleset rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// Flips lists the decisions made by decidedBy in [from, to) on which the
+// active and candidate rulesets do not all agree, most recent first, and
+// the total number of such decisions
+func (r *ShadowRepository) Flips(ctx context.Context, decidedBy string, from, to time.Time, limit int) ([]Flip, int, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT d.id::text, d.session_id::text, d.created_at,
+		       d.ruleset_version, d.status::text, d.score, d.reasons_json,
+		       jsonb_agg(jsonb_build_object(
+		           'version', s.ruleset_version,
+		           'status', s.status,
+		           'score', s.score,
+		           'reasons', s.reasons_json->'reasons'
+		       ) ORDER BY s.ruleset_version, s.ruleset_digest),
+		       count(*) OVER ()
+		FROM ekyc_decisions d
+		JOIN shadow_decisions s ON s.decision_id = d.id
+		WHERE d.decided_by = $1 AND d.created_at >= $2 AND d.created_at < $3
+		GROUP BY d.id
+		HAVING bool_or(s.status <> d.status) OR count(DISTINCT s.status) > 1
+		ORDER BY d.created_at DESC
+		LIMIT $4`,
+		decidedBy, from, to, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query decision flips: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		flips []Flip
+		total int
+	)
+	for rows.Next() {
+		var (
+			f          Flip
+			reasons    []byte
+			candidates []byte
+		)
+		err := rows.Scan(&f.DecisionID, &f.SessionID, &f.ScoredAt,
+			&f.Active.Version, &f.Active.Decision, &f.Active.Score, &reasons,
+			&candidates, &total,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan decision flip: %w", err)
+		}
+
+		var stored struct {
+			Reasons []rules.Reason `json:"reasons"`
+		}
+		if len(reasons) > 0 {
+			if err := json.Unmarshal(reasons, &stored); err != nil {
+				return nil, 0, fmt.Errorf("failed to decode decision reasons: %w", err)
+			}
+		}
+		f.Active.Reasons = stored.Reasons
+
+		if err := json.Unmarshal(candidates, &f.Candidates); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode shadow outcomes: %w", err)
+		}
+
+		flips = append(flips, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read decision flips: %w", err)
+	}
+
+	return flips, total, nil
+}
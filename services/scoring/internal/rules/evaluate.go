@@ -0,0 +1,133 @@
+package rules
+
+// Outcome is the result of evaluating a ruleset
+type Outcome struct {
+	Version  string
+	Digest   string
+	Score    int
+	Decision string
+	// Reasons are the rules that fired, in ruleset order
+	Reasons []Reason
+}
+
+// Reason is a rule that fired
+type Reason struct {
+	Code        string `json:"code"`
+	Description string `json:"description,omitempty"`
+	Weight      int    `json:"weight"`
+}
+
+// Codes returns the codes of the rules that fired
+func (o *Outcome) Codes() []string {
+	codes := make([]string, len(o.Reasons))
+	for i, r := range o.Reasons {
+		codes[i] = r.Code
+	}
+	return codes
+}
+
+// Evaluate scores a set of facts. Rules run in file order and only compare
+// values, so the same facts always give the same outcome.
+func (rs *Ruleset) Evaluate(facts Facts) *Outcome {
+	outcome := &Outcome{
+		Version: rs.Version,
+		Digest:  rs.Digest,
+		Reasons: []Reason{},
+	}
+
+	score := rs.Base()
+	floor := DecisionApproved
+	for _, rule := range rs.Rules {
+		if !rule.fires(facts) {
+			continue
+		}
+		score -= rule.Weight
+		outcome.Reasons = append(outcome.Reasons, Reason{Code: rule.Code, Description: rule.Description, Weight: rule.Weight})
+		if rule.Decision != "" && severity[rule.Decision] > severity[floor] {
+			floor = rule.Decision
+		}
+	}
+	outcome.Score = min(max(score, 0), MaxScore)
+
+	switch {
+	case outcome.Score >= rs.Thresholds.Approve:
+		outcome.Decision = DecisionApproved
+	case outcome.Score >= rs.Thresholds.Review:
+		outcome.Decision = DecisionReview
+	default:
+		outcome.Decision = DecisionRejected
+	}
+	if severity[floor] > severity[outcome.Decision] {
+		outcome.Decision = floor
+	}
+
+	return outcome
+}
+
+// fires reports whether all conditions of a rule hold
+func (r *Rule) fires(facts Facts) bool {
+	for _, c := range r.When {
+		if !c.holds(facts) {
+			return false
+		}
+	}
+	return true
+}
+
+// holds evaluates a condition. Apart from absent, every operator is false
+// for a fact that is not set.
+func (c *Condition) holds(facts Facts) bool {
+	fact, ok := facts[c.Fact]
+	switch c.Op {
+	case OpPresent:
+		return ok
+	case OpAbsent:
+		return !ok
+	}
+	if !ok {
+		return false
+	}
+
+	switch c.Op {
+	case OpEq:
+		return fact == c.Value
+	case OpNe:
+		return fact != c.Value
+	case OpLt, OpLte, OpGt, OpGte:
+		n, isNumber := fact.(float64)
+		if !isNumber {
+			return false
+		}
+		v := c.Value.(float64)
+		switch c.Op {
+		case OpLt:
+			return n < v
+		case OpLte:
+			return n <= v
+		case OpGt:
+			return n > v
+		default:
+			return n >= v
+		}
+	case OpIn:
+		for _, item := range c.Value.([]interface{}) {
+			if fact == item {
+				return true
+			}
+		}
+		return false
+	case OpContains:
+		list, isList := fact.([]string)
+		if !isList {
+			return false
+		}
+		for _, item := range list {
+			if item == c.Value {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
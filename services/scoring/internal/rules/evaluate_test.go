@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+const evaluateRuleset = `
+version: "test"
+base_score: 100
+thresholds:
+  approve: 80
+  review: 50
+rules:
+  - code: OCR_MISSING
+    when:
+      - { fact: ocr.present, op: eq, value: false }
+    weight: 50
+    decision: REVIEW
+  - code: FACE_LOW
+    when:
+      - { fact: face.similarity, op: lt, value: 0.6 }
+    weight: 30
+  - code: FACE_FAILED
+    when:
+      - { fact: face.passed, op: eq, value: false }
+      - { fact: face.similarity, op: present }
+    weight: 40
+  - code: BLACKLISTED
+    when:
+      - { fact: reasons, op: contains, value: BLACKLISTED }
+    weight: 0
+    decision: REJECTED
+  - code: PASSPORT
+    when:
+      - { fact: ocr.document_type, op: in, value: [PASSPORT] }
+    weight: 5
+  - code: NO_LIVENESS
+    when:
+      - { fact: liveness.confidence, op: absent }
+    weight: 10
+`
+
+func TestEvaluate(t *testing.T) {
+	rs, err := Parse([]byte(evaluateRuleset), false)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	passing := Facts{
+		"ocr.present":         true,
+		"face.similarity":     0.9,
+		"face.passed":         true,
+		"liveness.confidence": 0.95,
+		"reasons":             []string{},
+	}
+	with := func(overrides Facts) Facts {
+		facts := Facts{}
+		for k, v := range passing {
+			facts[k] = v
+		}
+		for k, v := range overrides {
+			facts[k] = v
+		}
+		return facts
+	}
+
+	tests := []struct {
+		name      string
+		facts     Facts
+		wantScore int
+		want      string
+		wantCodes []string
+	}{
+		{name: "clean session", facts: passing, wantScore: 100, want: DecisionApproved, wantCodes: []string{}},
+		{name: "minor deduction", facts: with(Facts{"ocr.document_type": "PASSPORT"}), wantScore: 95, want: DecisionApproved, wantCodes: []string{"PASSPORT"}},
+		{name: "review band", facts: with(Facts{"face.similarity": 0.5}), wantScore: 70, want: DecisionReview, wantCodes: []string{"FACE_LOW"}},
+		{
+			name:      "rejected by score",
+			facts:     with(Facts{"face.similarity": 0.5, "face.passed": false}),
+			wantScore: 30,
+			want:      DecisionRejected,
+			wantCodes: []string{"FACE_LOW", "FACE_FAILED"},
+		},
+		{
+			name:      "score floors at zero",
+			facts:     Facts{"ocr.present": false, "face.similarity": 0.1, "face.passed": false},
+			wantScore: 0,
+			want:      DecisionRejected,
+			wantCodes: []string{"OCR_MISSING", "FACE_LOW", "FACE_FAILED", "NO_LIVENESS"},
+		},
+		{
+			name:      "decision floor is stricter than score",
+			facts:     with(Facts{"reasons": []string{"BLACKLISTED"}}),
+			wantScore: 100,
+			want:      DecisionRejected,
+			wantCodes: []string{"BLACKLISTED"},
+		},
+		{
+			name:      "absent fact fires only absent",
+			facts:     Facts{"ocr.present": true},
+			wantScore: 90,
+			want:      DecisionApproved,
+			wantCodes: []string{"NO_LIVENESS"},
+		},
+		{
+			name:      "mistyped fact does not compare",
+			facts:     with(Facts{"face.similarity": "0.5"}),
+			wantScore: 100,
+			want:      DecisionApproved,
+			wantCodes: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rs.Evaluate(tt.facts)
+			if got.Score != tt.wantScore || got.Decision != tt.want {
+				t.Fatalf("Evaluate() = %d %s, want %d %s", got.Score, got.Decision, tt.wantScore, tt.want)
+			}
+			if codes := got.Codes(); !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Fatalf("Evaluate() codes = %v, want %v", codes, tt.wantCodes)
+			}
+			if got.Version != rs.Version || got.Digest != rs.Digest {
+				t.Fatalf("Evaluate() ruleset = %s %s, want %s %s", got.Version, got.Digest, rs.Version, rs.Digest)
+			}
+		})
+	}
+}
+
+func TestEvaluateIsDeterministic(t *testing.T) {
+	rs, err := Parse([]byte(evaluateRuleset), false)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	facts := Facts{"ocr.present": false, "face.similarity": 0.5, "face.passed": false}
+	first := rs.Evaluate(facts)
+	for i := 0; i < 10; i++ {
+		if got := rs.Evaluate(facts); !reflect.DeepEqual(got, first) {
+			t.Fatalf("Evaluate() = %+v, want %+v", got, first)
+		}
+	}
+}
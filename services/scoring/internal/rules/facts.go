@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"math"
+	"strings"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// Facts are the named values rules are evaluated against. Values are
+// float64, bool, string or []string; a fact that is not set is absent.
+type Facts map[string]interface{}
+
+// FactsFrom derives the facts of a score request. Personal data is never a
+// fact: OCR contributes the names of the fields it read, not their values.
+//
+//	reasons                   reason codes raised by earlier checks
+//	ocr.present               whether an OCR result was provided
+//	ocr.quality, ocr.document_type, ocr.fields
+//	face.present              whether a face match result was provided
+//	face.similarity, face.threshold, face.passed, face.confidence
+//	liveness.present          whether a liveness result was provided
+//	liveness.passed, liveness.confidence, liveness.type, liveness.metrics.<name>
+//	context.device_info, context.location, context.ip_address, context.<key>
+func FactsFrom(req *proto.ScoreRequest) Facts {
+	facts := Facts{
+		"reasons":          append([]string{}, req.GetReasons()...),
+		"ocr.present":      req.GetOcrResult() != nil,
+		"face.present":     req.GetFaceResult() != nil,
+		"liveness.present": req.GetLivenessResult() != nil,
+	}
+
+	if ocr := req.GetOcrResult(); ocr != nil {
+		facts["ocr.quality"] = number(ocr.GetQuality())
+		if ocr.GetDocumentType() != proto.DocumentType_DOCUMENT_TYPE_UNSPECIFIED {
+			facts["ocr.document_type"] = strings.TrimPrefix(ocr.GetDocumentType().String(), "DOCUMENT_TYPE_")
+		}
+
+		fields := []string{}
+		for name, value := range map[string]string{
+			"full_name":   ocr.GetFullName(),
+			"id_number":   ocr.GetIdNumber(),
+			"dob":         ocr.GetDob(),
+			"issue_date":  ocr.GetIssueDate(),
+			"expiry_date": ocr.GetExpiryDate(),
+			"address":     ocr.GetAddress(),
+		} {
+			if value != "" {
+				fields = append(fields, name)
+			}
+		}
+		for name, value := range ocr.GetExtractedFields() {
+			if value != "" {
+				fields = append(fields, name)
+			}
+		}
+		facts["ocr.fields"] = fields
+	}
+
+	if face := req.GetFaceResult(); face != nil {
+		facts["face.similarity"] = number(face.GetSimilarity())
+		facts["face.threshold"] = number(face.GetThreshold())
+		facts["face.passed"] = face.GetPassed()
+		facts["face.confidence"] = number(face.GetConfidence())
+	}
+
+	if liveness := req.GetLivenessResult(); liveness != nil {
+		facts["liveness.passed"] = liveness.GetPassed()
+		facts["liveness.confidence"] = number(liveness.GetConfidence())
+		if liveness.GetLivenessType() != "" {
+			facts["liveness.type"] = liveness.GetLivenessType()
+		}
+		for name, value := range liveness.GetMetrics() {
+			facts["liveness.metrics."+name] = number(value)
+		}
+	}
+
+	if c := req.GetContext(); c != nil {
+		for name, value := range c.GetAdditionalContext() {
+			if value != "" {
+				facts["context."+name] = value
+			}
+		}
+		for name, value := range map[string]string{
+			"device_info": c.GetDeviceInfo(),
+			"location":    c.GetLocation(),
+			"ip_address":  c.GetIpAddress(),
+		} {
+			if value != "" {
+				facts["context."+name] = value
+			}
+		}
+	}
+
+	return facts
+}
+
+// number widens a float32 result to float64, rounded to six decimals so that
+// 0.6 in a result compares equal to 0.6 in a ruleset
+func number(v float32) float64 {
+	return math.Round(float64(v)*1e6) / 1e6
+}
@@ -0,0 +1,284 @@
+package rules
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Decisions, matching the decision_status enum
+const (
+	DecisionApproved = "APPROVED"
+	DecisionReview   = "REVIEW"
+	DecisionRejected = "REJECTED"
+)
+
+// Condition operators
+const (
+	OpEq       = "eq"
+	OpNe       = "ne"
+	OpLt       = "lt"
+	OpLte      = "lte"
+	OpGt       = "gt"
+	OpGte      = "gte"
+	OpIn       = "in"
+	OpContains = "contains"
+	OpPresent  = "present"
+	OpAbsent   = "absent"
+)
+
+// MaxScore is the highest score; it is also the default base score
+const MaxScore = 100
+
+// severity orders decisions from most to least favourable
+var severity = map[string]int{
+	DecisionApproved: 0,
+	DecisionReview:   1,
+	DecisionRejected: 2,
+}
+
+// Ruleset is a versioned scoring policy. Every session starts at BaseScore;
+// each rule whose conditions all hold subtracts its Weight and reports its
+// code. The final score picks a decision through Thresholds, and a fired rule
+// with a Decision can make it stricter but never more lenient.
+type Ruleset struct {
+	Version    string     `yaml:"version" json:"version"`
+	BaseScore  *int       `yaml:"base_score" json:"base_score"`
+	Thresholds Thresholds `yaml:"thresholds" json:"thresholds"`
+	Rules      []Rule     `yaml:"rules" json:"rules"`
+
+	// Digest is the SHA-256 of the file the ruleset was loaded from, so a
+	// decision can be traced to the exact policy even if a version is reused
+	Digest string `yaml:"-" json:"-"`
+}
+
+// Thresholds are the lowest scores that are approved or sent to review.
+// Lower scores are rejected.
+type Thresholds struct {
+	Approve int `yaml:"approve" json:"approve"`
+	Review  int `yaml:"review" json:"review"`
+}
+
+// Rule is a weighted check over the facts of a session
+type Rule struct {
+	// Code is reported when the rule fires
+	Code string `yaml:"code" json:"code"`
+	// Description explains the code to reviewers
+	Description string `yaml:"description" json:"description"`
+	// When lists conditions that must all hold for the rule to fire
+	When []Condition `yaml:"when" json:"when"`
+	// Weight is subtracted from the score when the rule fires
+	Weight int `yaml:"weight" json:"weight"`
+	// Decision, if set, is the most lenient decision allowed once the rule fires
+	Decision string `yaml:"decision,omitempty" json:"decision,omitempty"`
+}
+
+// Condition compares one fact with a value
+type Condition struct {
+	Fact  string      `yaml:"fact" json:"fact"`
+	Op    string      `yaml:"op" json:"op"`
+	Value interface{} `yaml:"value,omitempty" json:"value,omitempty"`
+}
+
+// Load reads a ruleset from a .yaml, .yml or .json file
+func Load(path string) (*Ruleset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ruleset: %w", err)
+	}
+
+	rs, err := Parse(data, strings.ToLower(filepath.Ext(path)) == ".json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid ruleset %s: %w", path, err)
+	}
+
+	return rs, nil
+}
+
+// Parse decodes and validates a ruleset. Unknown keys are rejected so a typo
+// cannot silently disable part of a policy.
+func Parse(data []byte, isJSON bool) (*Ruleset, error) {
+	var rs Ruleset
+
+	if isJSON {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		decoder.UseNumber()
+		if err := decoder.Decode(&rs); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON: %w", err)
+		}
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&rs); err != nil {
+			return nil, fmt.Errorf("failed to decode YAML: %w", err)
+		}
+	}
+
+	if err := rs.validate(); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	rs.Digest = hex.EncodeToString(sum[:])
+
+	return &rs, nil
+}
+
+// Base returns the score a session starts from
+func (rs *Ruleset) Base() int {
+	if rs.BaseScore == nil {
+		return MaxScore
+	}
+	return *rs.BaseScore
+}
+
+// validate checks the ruleset and normalizes condition values
+func (rs *Ruleset) validate() error {
+	if strings.TrimSpace(rs.Version) == "" {
+		return fmt.Errorf("version is required")
+	}
+	if base := rs.Base(); base < 0 || base > MaxScore {
+		return fmt.Errorf("base_score must be between 0 and %d", MaxScore)
+	}
+	if rs.Thresholds.Review < 0 || rs.Thresholds.Approve > MaxScore || rs.Thresholds.Review > rs.Thresholds.Approve {
+		return fmt.Errorf("thresholds must satisfy 0 <= review <= approve <= %d", MaxScore)
+	}
+	if len(rs.Rules) == 0 {
+		return fmt.Errorf("at least one rule is required")
+	}
+
+	codes := make(map[string]bool, len(rs.Rules))
+	for i := range rs.Rules {
+		rule := &rs.Rules[i]
+		if rule.Code == "" {
+			return fmt.Errorf("rule %d: code is required", i+1)
+		}
+		if codes[rule.Code] {
+			return fmt.Errorf("rule %s: duplicate code", rule.Code)
+		}
+		codes[rule.Code] = true
+
+		if rule.Decision != "" {
+			if _, ok := severity[rule.Decision]; !ok || rule.Decision == DecisionApproved {
+				return fmt.Errorf("rule %s: decision must be %s or %s", rule.Code, DecisionReview, DecisionRejected)
+			}
+		}
+		if len(rule.When) == 0 {
+			return fmt.Errorf("rule %s: at least one condition is required", rule.Code)
+		}
+		for j := range rule.When {
+			if err := rule.When[j].normalize(); err != nil {
+				return fmt.Errorf("rule %s: condition %d: %w", rule.Code, j+1, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalize checks a condition and converts its value to the types the
+// evaluator compares: float64, bool, string or []interface{} of those
+func (c *Condition) normalize() error {
+	if c.Fact == "" {
+		return fmt.Errorf("fact is required")
+	}
+
+	value, err := normalizeValue(c.Value)
+	if err != nil {
+		return err
+	}
+	c.Value = value
+
+	switch c.Op {
+	case OpPresent, OpAbsent:
+		if c.Value != nil {
+			return fmt.Errorf("%s takes no value", c.Op)
+		}
+	case OpLt, OpLte, OpGt, OpGte:
+		if _, ok := c.Value.(float64); !ok {
+			return fmt.Errorf("%s needs a numeric value", c.Op)
+		}
+	case OpIn:
+		if _, ok := c.Value.([]interface{}); !ok {
+			return fmt.Errorf("%s needs a list value", c.Op)
+		}
+	case OpEq, OpNe, OpContains:
+		if c.Value == nil {
+			return fmt.Errorf("%s needs a value", c.Op)
+		}
+		if _, ok := c.Value.([]interface{}); ok {
+			return fmt.Errorf("%s needs a single value", c.Op)
+		}
+	default:
+		return fmt.Errorf("unknown operator %q", c.Op)
+	}
+
+	return nil
+}
+
+// normalizeValue converts decoded YAML and JSON numbers to float64
+func normalizeValue(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case nil, bool, string, float64:
+		return value, nil
+	case int:
+		return float64(value), nil
+	case json.Number:
+		f, err := value.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", value)
+		}
+		return f, nil
+	case []interface{}:
+		list := make([]interface{}, len(value))
+		for i, item := range value {
+			normalized, err := normalizeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			if _, nested := normalized.([]interface{}); nested || normalized == nil {
+				return nil, fmt.Errorf("list items must be numbers, booleans or strings")
+			}
+			list[i] = normalized
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("unsupported value %v", v)
+	}
+}
+
+// LoadCandidates reads a comma-separated list of candidate rulesets. Their
+// versions must differ from each other and from the active ruleset, since
+// shadow outcomes are reported by version.
+func LoadCandidates(paths string, active *Ruleset) ([]*Ruleset, error) {
+	var candidates []*Ruleset
+	versions := map[string]bool{active.Version: true}
+
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		rs, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		if versions[rs.Version] {
+			return nil, fmt.Errorf("candidate ruleset %s: version %s is already loaded", path, rs.Version)
+		}
+		versions[rs.Version] = true
+
+		candidates = append(candidates, rs)
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testRuleset = `
+version: "test"
+thresholds:
+  approve: 80
+  review: 50
+rules:
+  - code: FACE_LOW
+    when:
+      - { fact: face.similarity, op: lt, value: 0.6 }
+    weight: 40
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		isJSON  bool
+		wantErr string
+	}{
+		{name: "yaml", data: testRuleset},
+		{
+			name:   "json",
+			data:   `{"version": "test", "thresholds": {"approve": 80, "review": 50}, "rules": [{"code": "FACE_LOW", "when": [{"fact": "face.similarity", "op": "lt", "value": 0.6}], "weight": 40}]}`,
+			isJSON: true,
+		},
+		{name: "unknown key", data: testRuleset + "extra: true\n", wantErr: "field extra not found"},
+		{name: "unknown json key", data: `{"version": "test", "rulez": []}`, isJSON: true, wantErr: "unknown field"},
+		{name: "missing version", data: strings.Replace(testRuleset, `version: "test"`, "", 1), wantErr: "version is required"},
+		{name: "base score out of range", data: testRuleset + "base_score: 101\n", wantErr: "base_score"},
+		{name: "review above approve", data: strings.Replace(testRuleset, "review: 50", "review: 90", 1), wantErr: "thresholds"},
+		{name: "no rules", data: "version: test\nthresholds: {approve: 80, review: 50}\n", wantErr: "at least one rule"},
+		{
+			name:    "duplicate code",
+			data:    testRuleset + "  - code: FACE_LOW\n    when: [{ fact: face.passed, op: eq, value: false }]\n    weight: 10\n",
+			wantErr: "duplicate code",
+		},
+		{
+			name:    "approve decision",
+			data:    testRuleset + "    decision: APPROVED\n",
+			wantErr: "decision must be",
+		},
+		{name: "unknown operator", data: strings.Replace(testRuleset, "op: lt", "op: below", 1), wantErr: "unknown operator"},
+		{name: "non-numeric comparison", data: strings.Replace(testRuleset, "value: 0.6", "value: low", 1), wantErr: "numeric value"},
+		{
+			name:    "present with value",
+			data:    strings.Replace(testRuleset, "op: lt, value: 0.6", "op: present, value: 0.6", 1),
+			wantErr: "takes no value",
+		},
+		{
+			name:    "in without list",
+			data:    strings.Replace(testRuleset, "op: lt, value: 0.6", "op: in, value: 0.6", 1),
+			wantErr: "list value",
+		},
+		{
+			name:    "nested list",
+			data:    strings.Replace(testRuleset, "op: lt, value: 0.6", "op: in, value: [[0.6]]", 1),
+			wantErr: "list items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := Parse([]byte(tt.data), tt.isJSON)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if rs.Version != "test" || rs.Base() != MaxScore {
+				t.Fatalf("Parse() version = %q base = %d, want test and %d", rs.Version, rs.Base(), MaxScore)
+			}
+			if got := rs.Rules[0].When[0].Value; got != 0.6 {
+				t.Fatalf("Parse() condition value = %#v, want float64 0.6", got)
+			}
+		})
+	}
+}
+
+func TestParseNormalizesValues(t *testing.T) {
+	rs, err := Parse([]byte(strings.Replace(testRuleset, "op: lt, value: 0.6", "op: in, value: [1, true, CCCD]", 1)), false)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []interface{}{float64(1), true, "CCCD"}
+	if got := rs.Rules[0].When[0].Value; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Parse() condition value = %#v, want %#v", got, want)
+	}
+}
+
+func TestParseDigest(t *testing.T) {
+	sum := sha256.Sum256([]byte(testRuleset))
+	want := hex.EncodeToString(sum[:])
+
+	first, err := Parse([]byte(testRuleset), false)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	second, err := Parse([]byte(testRuleset), false)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if first.Digest != want || second.Digest != want {
+		t.Fatalf("Parse() digest = %s and %s, want %s", first.Digest, second.Digest, want)
+	}
+
+	// A change that leaves the policy as it was still changes the digest,
+	// since it is taken over the file
+	edited, err := Parse([]byte(testRuleset+"# comment\n"), false)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if edited.Digest == want {
+		t.Fatalf("Parse() digest of an edited file = %s, want a different digest", edited.Digest)
+	}
+	if got := edited.Evaluate(Facts{}).Digest; got != edited.Digest {
+		t.Fatalf("Evaluate() digest = %s, want %s", got, edited.Digest)
+	}
+}
+
+func TestLoadShippedRuleset(t *testing.T) {
+	rs, err := Load("../../rules/v1.yaml")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if rs.Version != "v1" || rs.Digest == "" {
+		t.Fatalf("Load() version = %q digest = %q, want v1 and a digest", rs.Version, rs.Digest)
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekyc-backend/pkg/reasons"
+	"github.com/ekyc-backend/services/scoring/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// resultPayload is the part of ekyc_results.payload_json that scoring reads.
+// Each check stores its result message under its own key.
+type resultPayload struct {
+	Reasons  []reasons.Reason `json:"reasons"`
+	OCR      json.RawMessage  `json:"ocr"`
+	Face     json.RawMessage  `json:"face"`
+	Liveness json.RawMessage  `json:"liveness"`
+}
+
+// HandleSessionStatusChanged scores a session once identity puts it under
+// review, from the check results stored for it. The decision is published
+// as kyc.scored for identity to apply. A redelivered event returns the
+// decision recorded first.
+func (s *ScoringServer) HandleSessionStatusChanged(ctx context.Context, event *proto.SessionStatusChanged) error {
+	if event.GetStatus() != proto.SessionStatus_UNDER_REVIEW.String() {
+		return nil
+	}
+
+	results, err := s.results.ListBySession(ctx, event.GetSessionId())
+	if err != nil {
+		return err
+	}
+	req, err := scoreRequest(event.GetSessionId(), results)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Score(ctx, req)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument, codes.NotFound:
+		s.logger.WithContext(ctx).WithSessionID(event.GetSessionId()).Warn("Ignoring session under review", zap.Error(err))
+		return nil
+	default:
+		return err
+	}
+}
+
+// scoreRequest builds the score request of a session from its stored
+// results, given oldest first. The OCR results of the sides of a document
+// are merged; for face match and liveness the latest result counts. Reason
+// codes of every result are listed once, in the order they were raised.
+func scoreRequest(sessionID string, results []*repository.Result) (*proto.ScoreRequest, error) {
+	req := &proto.ScoreRequest{SessionId: sessionID}
+	seen := map[string]bool{}
+
+	for _, result := range results {
+		var payload resultPayload
+		if err := json.Unmarshal(result.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s result %s: %w", result.Kind, result.ID, err)
+		}
+
+		var err error
+		switch result.Kind {
+		case repository.KindOCR:
+			var ocr proto.OCRResult
+			if err = unmarshalResult(payload.OCR, &ocr); err == nil {
+				req.OcrResult = mergeOCR(req.OcrResult, &ocr)
+			}
+		case repository.KindFace:
+			req.FaceResult = &proto.FaceMatchResult{}
+			err = unmarshalResult(payload.Face, req.FaceResult)
+		case repository.KindLiveness:
+			req.LivenessResult = &proto.LivenessResult{}
+			err = unmarshalResult(payload.Liveness, req.LivenessResult)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s result %s: %w", result.Kind, result.ID, err)
+		}
+
+		for _, r := range payload.Reasons {
+			if r.Code != "" && !seen[r.Code] {
+				seen[r.Code] = true
+				req.Reasons = append(req.Reasons, r.Code)
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// unmarshalResult decodes a result message stored by a check. Results
+// stored without the message decode as empty.
+func unmarshalResult(data json.RawMessage, m protoreflect.ProtoMessage) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+}
+
+// mergeOCR folds the OCR result of another side of a document into merged:
+// the lower quality counts, and fields read on either side are kept
+func mergeOCR(merged, side *proto.OCRResult) *proto.OCRResult {
+	if merged == nil {
+		return side
+	}
+
+	if side.GetQuality() < merged.GetQuality() {
+		merged.Quality = side.GetQuality()
+	}
+	if merged.GetDocumentType() == proto.DocumentType_DOCUMENT_TYPE_UNSPECIFIED {
+		merged.DocumentType = side.GetDocumentType()
+	}
+	if merged.GetIssueDate() == "" {
+		merged.IssueDate = side.GetIssueDate()
+	}
+	if merged.GetExpiryDate() == "" {
+		merged.ExpiryDate = side.GetExpiryDate()
+	}
+	for name, value := range side.GetExtractedFields() {
+		if merged.ExtractedFields == nil {
+			merged.ExtractedFields = map[string]string{}
+		}
+		if merged.ExtractedFields[name] == "" {
+			merged.ExtractedFields[name] = value
+		}
+	}
+
+	return merged
+}
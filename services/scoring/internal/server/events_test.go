@@ -0,0 +1,107 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ekyc-backend/services/scoring/internal/repository"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func TestScoreRequest(t *testing.T) {
+	results := []*repository.Result{
+		{ID: "front", Kind: repository.KindOCR, Payload: []byte(`{
+			"artifact_id": "a1", "engine": "fixture", "fields": ["full_name", "id_number"],
+			"reasons": [{"code": "MRZ_MISSING"}],
+			"ocr": {"quality": 0.9, "documentType": "DOCUMENT_TYPE_CCCD", "extractedFields": {"nationality": "VNM"}}
+		}`)},
+		{ID: "back", Kind: repository.KindOCR, Payload: []byte(`{
+			"reasons": [{"code": "FIELD_MISSING", "field": "address"}, {"code": "MRZ_MISSING"}],
+			"ocr": {"quality": 0.7, "issueDate": "2021-04-01", "extractedFields": {"place_of_issue": "HN"}}
+		}`)},
+		{ID: "face-old", Kind: repository.KindFace, Payload: []byte(`{"reasons": [], "face": {"similarity": 0.2, "passed": false}}`)},
+		{ID: "face", Kind: repository.KindFace, Payload: []byte(`{"reasons": [], "face": {"similarity": 0.8, "threshold": 0.4, "passed": true, "confidence": 0.95}}`)},
+		{ID: "liveness", Kind: repository.KindLiveness, Payload: []byte(`{
+			"mode": "active", "challenge_id": "c1", "reasons": [{"code": "LIVENESS_CHALLENGE_MISMATCH"}],
+			"liveness": {"passed": false, "confidence": 0.3, "livenessType": "ACTIVE"}
+		}`)},
+	}
+
+	req, err := scoreRequest("session-1", results)
+	if err != nil {
+		t.Fatalf("scoreRequest() error = %v", err)
+	}
+
+	if req.GetSessionId() != "session-1" {
+		t.Errorf("session_id = %q, want session-1", req.GetSessionId())
+	}
+
+	ocr := req.GetOcrResult()
+	if ocr.GetQuality() != 0.7 {
+		t.Errorf("ocr quality = %v, want the lower 0.7", ocr.GetQuality())
+	}
+	if ocr.GetDocumentType() != proto.DocumentType_DOCUMENT_TYPE_CCCD {
+		t.Errorf("ocr document_type = %v, want CCCD", ocr.GetDocumentType())
+	}
+	if ocr.GetIssueDate() != "2021-04-01" {
+		t.Errorf("ocr issue_date = %q, want the back side's", ocr.GetIssueDate())
+	}
+	if want := map[string]string{"nationality": "VNM", "place_of_issue": "HN"}; !reflect.DeepEqual(ocr.GetExtractedFields(), want) {
+		t.Errorf("ocr extracted_fields = %v, want %v", ocr.GetExtractedFields(), want)
+	}
+
+	face := req.GetFaceResult()
+	if !face.GetPassed() || face.GetSimilarity() != 0.8 {
+		t.Errorf("face = %v, want the latest result", face)
+	}
+
+	liveness := req.GetLivenessResult()
+	if liveness.GetPassed() || liveness.GetLivenessType() != "ACTIVE" {
+		t.Errorf("liveness = %v, want failed ACTIVE", liveness)
+	}
+
+	if want := []string{"MRZ_MISSING", "FIELD_MISSING", "LIVENESS_CHALLENGE_MISMATCH"}; !reflect.DeepEqual(req.GetReasons(), want) {
+		t.Errorf("reasons = %v, want %v", req.GetReasons(), want)
+	}
+}
+
+func TestScoreRequestMissingResults(t *testing.T) {
+	req, err := scoreRequest("session-1", []*repository.Result{
+		{ID: "face", Kind: repository.KindFace, Payload: []byte(`{"reasons": [{"code": "FACE_NOT_DETECTED"}]}`)},
+	})
+	if err != nil {
+		t.Fatalf("scoreRequest() error = %v", err)
+	}
+
+	if req.GetOcrResult() != nil || req.GetLivenessResult() != nil {
+		t.Errorf("scoreRequest() = %v, want no OCR or liveness result", req)
+	}
+	if req.GetFaceResult() == nil {
+		t.Errorf("face result = nil, want an empty result for a stored check")
+	}
+	if want := []string{"FACE_NOT_DETECTED"}; !reflect.DeepEqual(req.GetReasons(), want) {
+		t.Errorf("reasons = %v, want %v", req.GetReasons(), want)
+	}
+}
+
+func TestScoreRequestInvalidPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{name: "not JSON", payload: `{`},
+		{name: "malformed result", payload: `{"face": {"similarity": "high"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := scoreRequest("session-1", []*repository.Result{
+				{ID: "face", Kind: repository.KindFace, Payload: []byte(tt.payload)},
+			})
+			if err == nil {
+				t.Errorf("scoreRequest(%s) error = nil, want error", tt.payload)
+			}
+		})
+	}
+}
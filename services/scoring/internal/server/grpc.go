@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/services/scoring/internal/repository"
+	"github.com/ekyc-backend/services/scoring/internal/rules"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+// ScoringActor is recorded as decided_by on automated decisions
+const ScoringActor = "scoring"
+
+const (
+	// DefaultShadowWindow is how far back a shadow report looks by default
+	DefaultShadowWindow = 7 * 24 * time.Hour
+	// DefaultFlipLimit and MaxFlipLimit bound the flips listed in a report
+	DefaultFlipLimit = 100
+	MaxFlipLimit     = 1000
+)
+
+// sessionStatuses maps decisions to the session status they lead to. Identity
+// applies the same mapping when it receives the kyc.scored event.
+var sessionStatuses = map[string]proto.SessionStatus{
+	rules.DecisionApproved: proto.SessionStatus_APPROVED,
+	rules.DecisionReview:   proto.SessionStatus_UNDER_REVIEW,
+	rules.DecisionRejected: proto.SessionStatus_REJECTED,
+}
+
+// decisionStatuses maps decisions to their API representation
+var decisionStatuses = map[string]proto.DecisionStatus{
+	rules.DecisionApproved: proto.DecisionStatus_DECISION_APPROVED,
+	rules.DecisionReview:   proto.DecisionStatus_DECISION_REVIEW,
+	rules.DecisionRejected: proto.DecisionStatus_DECISION_REJECTED,
+}
+
+// ScoringServer implements the ScoringService gRPC API
+type ScoringServer struct {
+	proto.UnimplementedScoringServiceServer
+
+	ruleset *rules.Ruleset
+	// candidates are evaluated in shadow on every call; their outcomes are
+	// recorded for comparison but never decide a session
+	candidates []*rules.Ruleset
+	decisions  *repository.DecisionRepository
+	shadows    *repository.ShadowRepository
+	results    *repository.ResultRepository
+	logger     *logger.Logger
+}
+
+// NewScoringServer creates a new scoring gRPC server
+func NewScoringServer(ruleset *rules.Ruleset, candidates []*rules.Ruleset, decisions *repository.DecisionRepository, shadows *repository.ShadowRepository, results *repository.ResultRepository, logger *logger.Logger) *ScoringServer {
+	return &ScoringServer{
+		ruleset:    ruleset,
+		candidates: candidates,
+		decisions:  decisions,
+		shadows:    shadows,
+		results:    results,
+		logger:     logger,
+	}
+}
+
+// Score evaluates the active ruleset against the results of a session and
+// records the decision with the ruleset version. Candidate rulesets are
+// evaluated on the same facts and recorded in shadow. A session already scored
+// with the active ruleset gets the decision recorded then.
+func (s *ScoringServer) Score(ctx context.Context, req *proto.ScoreRequest) (*proto.ScoreResponse, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	facts := rules.FactsFrom(req)
+	outcome := s.ruleset.Evaluate(facts)
+
+	decision, err := s.decisions.Save(ctx, req.GetSessionId(), outcome, ScoringActor)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	log := s.logger.WithContext(ctx).WithSessionID(req.GetSessionId())
+	if decision.Replayed {
+		// A retried call returns the decision recorded first. Its shadow
+		// outcomes were recorded with it, from the facts it was scored on.
+		log.Info("Session already scored with this ruleset", zap.String("decision_id", decision.ID))
+		return scoreResponse(decision), nil
+	}
+
+	log.Info("Session scored",
+		zap.String("decision_id", decision.ID),
+		zap.String("ruleset_version", outcome.Version),
+		zap.Int("score", outcome.Score),
+		zap.String("decision", outcome.Decision),
+		zap.Strings("reasons", outcome.Codes()),
+	)
+
+	if len(s.candidates) > 0 {
+		shadows := make([]*rules.Outcome, len(s.candidates))
+		for i, candidate := range s.candidates {
+			shadows[i] = candidate.Evaluate(facts)
+		}
+		// A shadow outcome that cannot be recorded must not fail the decision
+		if err := s.shadows.Save(ctx, decision, shadows); err != nil {
+			log.Error("Failed to record shadow decisions", zap.String("decision_id", decision.ID), zap.Error(err))
+		}
+	}
+
+	return scoreResponse(decision), nil
+}
+
+// scoreResponse describes a recorded decision
+func scoreResponse(decision *repository.Decision) *proto.ScoreResponse {
+	return &proto.ScoreResponse{
+		SessionId:      decision.SessionID,
+		Status:         sessionStatuses[decision.Outcome.Decision],
+		Score:          int32(decision.Outcome.Score),
+		Reasons:        decision.Outcome.Codes(),
+		ScoredAt:       timestamppb.New(decision.CreatedAt),
+		RulesetVersion: decision.Outcome.Version,
+	}
+}
+
+// GetShadowReport compares the decisions of the active ruleset with the
+// outcomes of the candidate rulesets evaluated in shadow over a time window
+func (s *ScoringServer) GetShadowReport(ctx context.Context, req *proto.ShadowReportRequest) (*proto.ShadowReport, error) {
+	to := time.Now().UTC()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.Add(-DefaultShadowWindow)
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	limit := int(req.GetFlipLimit())
+	switch {
+	case limit < 0 || limit > MaxFlipLimit:
+		return nil, status.Errorf(codes.InvalidArgument, "flip_limit must be between 0 and %d", MaxFlipLimit)
+	case limit == 0:
+		limit = DefaultFlipLimit
+	}
+
+	rates, err := s.shadows.Rates(ctx, ScoringActor, from, to)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+	flips, total, err := s.shadows.Flips(ctx, ScoringActor, from, to, limit)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	report := &proto.ShadowReport{
+		From:       timestamppb.New(from),
+		To:         timestamppb.New(to),
+		TotalFlips: int32(total),
+	}
+	for _, rr := range rates {
+		if rr.Active {
+			report.Decisions += int32(rr.Total)
+		}
+		report.Rulesets = append(report.Rulesets, ratesToProto(rr))
+	}
+	for _, f := range flips {
+		flip := &proto.DecisionFlip{
+			DecisionId: f.DecisionID,
+			SessionId:  f.SessionID,
+			ScoredAt:   timestamppb.New(f.ScoredAt),
+			Active:     outcomeToProto(f.Active),
+		}
+		for _, candidate := range f.Candidates {
+			flip.Candidates = append(flip.Candidates, outcomeToProto(candidate))
+		}
+		report.Flips = append(report.Flips, flip)
+	}
+
+	return report, nil
+}
+
+// ratesToProto converts outcome counts to rates
+func ratesToProto(rr repository.RulesetRates) *proto.RulesetRates {
+	rates := &proto.RulesetRates{
+		RulesetVersion: rr.Version,
+		RulesetDigest:  rr.Digest,
+		Active:         rr.Active,
+		Decisions:      int32(rr.Total),
+		Approved:       int32(rr.Approved),
+		Review:         int32(rr.Review),
+		Rejected:       int32(rr.Rejected),
+		AverageScore:   rr.AvgScore,
+		Flipped:        int32(rr.Flipped),
+	}
+	if rr.Total > 0 {
+		rates.ApprovalRate = float64(rr.Approved) / float64(rr.Total)
+		rates.ReviewRate = float64(rr.Review) / float64(rr.Total)
+		rates.RejectionRate = float64(rr.Rejected) / float64(rr.Total)
+	}
+	return rates
+}
+
+// outcomeToProto converts a stored ruleset outcome
+func outcomeToProto(o repository.RulesetOutcome) *proto.RulesetOutcome {
+	reasonCodes := make([]string, len(o.Reasons))
+	for i, r := range o.Reasons {
+		reasonCodes[i] = r.Code
+	}
+	return &proto.RulesetOutcome{
+		RulesetVersion: o.Version,
+		Decision:       decisionStatuses[o.Decision],
+		Score:          int32(o.Score),
+		Reasons:        reasonCodes,
+	}
+}
+
+// toStatusError maps repository errors to gRPC status errors
+func (s *ScoringServer) toStatusError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, apperrors.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "session not found")
+	default:
+		s.logger.WithContext(ctx).Error("Scoring request failed", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
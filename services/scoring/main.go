@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/scoring/internal/repository"
+	"github.com/ekyc-backend/services/scoring/internal/rules"
+	"github.com/ekyc-backend/services/scoring/internal/server"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Scoring service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize event bus for session status changes and the decisions
+	// written to the outbox
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// Publish the outbox; relays in other services take turns through its lock
+	relay, err := events.NewOutboxRelay(database, bus, cfg, log)
+	if err != nil {
+		log.Fatal("Failed to initialize outbox relay", zap.Error(err))
+	}
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		relay.Run(relayCtx)
+	}()
+
+	// Load the active scoring ruleset
+	ruleset, err := rules.Load(cfg.ScoringRuleset)
+	if err != nil {
+		log.Fatal("Failed to load scoring ruleset", zap.Error(err))
+	}
+	log.Info("Scoring ruleset loaded",
+		zap.String("version", ruleset.Version),
+		zap.String("digest", ruleset.Digest),
+		zap.Int("rules", len(ruleset.Rules)),
+	)
+
+	// Load the candidate rulesets evaluated in shadow
+	candidates, err := rules.LoadCandidates(cfg.ScoringCandidateRulesets, ruleset)
+	if err != nil {
+		log.Fatal("Failed to load candidate scoring rulesets", zap.Error(err))
+	}
+	for _, candidate := range candidates {
+		log.Info("Candidate scoring ruleset loaded",
+			zap.String("version", candidate.Version),
+			zap.String("digest", candidate.Digest),
+			zap.Int("rules", len(candidate.Rules)),
+		)
+	}
+
+	scoringServer := server.NewScoringServer(ruleset, candidates,
+		repository.NewDecisionRepository(database),
+		repository.NewShadowRepository(database),
+		repository.NewResultRepository(database),
+		log,
+	)
+
+	// Score sessions as soon as identity puts them under review
+	if err := events.Subscribe(context.Background(), bus, cfg.ServiceName, scoringServer.HandleSessionStatusChanged); err != nil {
+		log.Fatal("Failed to subscribe to session status changes", zap.Error(err))
+	}
+
+	// Initialize Redis for the token denylist written by the gateway
+	redisClient, err := storage.NewRedis(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to Redis", zap.Error(err))
+	}
+	defer redisClient.Close()
+
+	// Initialize token verification against the gateway's published keys and
+	// revocations
+	verifier := jwtkeys.NewVerifier(jwtkeys.NewRemoteKeySet(cfg.JWKSURL, jwtkeys.DefaultJWKSRefreshInterval), cfg.JWTIssuer)
+	denylist := jwtkeys.NewDenylist(redisClient)
+	authPolicy := grpcmw.NewAuthPolicy().
+		RequireAnyRole("/ekyc.ScoringService/Score", "ADMIN").
+		RequireAnyRole("/ekyc.ScoringService/GetShadowReport", "ADMIN")
+
+	// Initialize mTLS for service-to-service traffic
+	tlsSource, err := mtls.Load(mtls.OptionsFromConfig(cfg), log)
+	if err != nil {
+		log.Fatal("Failed to load mTLS configuration", zap.Error(err))
+	}
+	if tlsSource != nil {
+		defer tlsSource.Close()
+	}
+
+	// Only the gateway calls this service
+	peers := mtls.NewPeerPolicy(cfg.TLSTrustDomain).Allow("api-gateway")
+
+	// Initialize gRPC server
+	serverOptions := append(mtls.ServerOptions(tlsSource, peers),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryTracingInterceptor(cfg.ServiceName),
+			grpcmw.UnaryLoggingInterceptor(log),
+			grpcmw.UnaryAuthInterceptor(verifier, denylist, authPolicy, log),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamTracingInterceptor(cfg.ServiceName),
+			grpcmw.StreamLoggingInterceptor(log),
+			grpcmw.StreamAuthInterceptor(verifier, denylist, authPolicy, log),
+		),
+	)
+	grpcServer := grpc.NewServer(serverOptions...)
+	proto.RegisterScoringServiceServer(grpcServer, scoringServer)
+
+	listener, err := net.Listen("tcp", cfg.GetGRPCAddr())
+	if err != nil {
+		log.Fatal("Failed to listen for gRPC", zap.Error(err))
+	}
+
+	go func() {
+		log.Info("Starting gRPC server", zap.String("addr", cfg.GetGRPCAddr()))
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	// Initialize HTTP server for health checks
+	httpServer := server.NewHTTPServer(cfg)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcServer.GracefulStop()
+
+	// Stop the relay after the last decision; unsent events are published
+	// by the next relay to run
+	stopRelay()
+	<-relayDone
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}
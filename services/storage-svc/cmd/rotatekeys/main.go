@@ -0,0 +1,57 @@
+// Command rotatekeys rewraps every artifact data key under the current
+// primary KMS master key. Run it after adding a master key with kmskey and
+// restarting storage-svc; the objects themselves are left untouched. The
+// person_pii data keys are rewrapped by pii's piirotatekeys command; older
+// master keys can be removed from the key file once both report no stale keys.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/storage"
+	"go.uber.org/zap"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 500, "data keys rewrapped per batch")
+	flag.Parse()
+
+	cfg := config.Load()
+
+	log := logger.New("rotatekeys")
+	defer log.Sync()
+
+	kms, err := crypto.LoadKMS(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to load KMS", zap.Error(err))
+	}
+
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Rotation only touches the key table, so no object store is needed
+	store := storage.NewEncryptedStore(nil, kms, storage.NewDataKeys(database), log)
+
+	ctx := context.Background()
+	total := 0
+	for {
+		rotated, err := store.RotateDataKeys(ctx, *batchSize)
+		if err != nil {
+			log.Fatal("Failed to rotate data keys", zap.Error(err), zap.Int("rotated", total))
+		}
+		if rotated == 0 {
+			break
+		}
+		total += rotated
+	}
+
+	log.Info("Data key rotation complete", zap.Int("rotated", total))
+}
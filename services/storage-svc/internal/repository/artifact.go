@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/db"
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// Artifact is a confirmed upload belonging to a session
+type Artifact struct {
+	ID          string
+	SessionID   string
+	Type        string
+	Key         string
+	ContentType string
+	Size        int64
+	ETag        string
+	// DocumentType is the short document type name of identity documents
+	DocumentType string
+	// ChecksumSHA256 is the base64 SHA-256 of the object, verified on ingest
+	ChecksumSHA256 string
+	CreatedAt      time.Time
+}
+
+// SessionRef is the part of a session needed to authorize artifact access
+type SessionRef struct {
+	ID     string
+	UserID string
+	Status string
+}
+
+// artifactMeta is the shape stored in ekyc_artifacts.meta_json
+type artifactMeta struct {
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+	ETag        string `json:"etag"`
+	// DocumentType is set for identity documents
+	DocumentType string `json:"document_type,omitempty"`
+	// ChecksumSHA256 is the base64 SHA-256 of the object, verified on ingest
+	ChecksumSHA256 string `json:"checksum_sha256,omitempty"`
+}
+
+// ArtifactRepository persists uploaded artifacts
+type ArtifactRepository struct {
+	db *db.DB
+}
+
+// NewArtifactRepository creates a new artifact repository
+func NewArtifactRepository(database *db.DB) *ArtifactRepository {
+	return &ArtifactRepository{db: database}
+}
+
+// GetSession loads the owner and status of a session
+func (r *ArtifactRepository) GetSession(ctx context.Context, sessionID string) (*SessionRef, error) {
+	var ref SessionRef
+
+	err := r.db.QueryRow(ctx, `
+		SELECT id::text, COALESCE(user_id::text, ''), status::text
+		FROM ekyc_sessions WHERE id = $1`,
+		sessionID,
+	).Scan(&ref.ID, &ref.UserID, &ref.Status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return &ref, nil
+}
+
+// Create records a confirmed upload. Confirming the same key again refreshes
+// its metadata and returns the existing row.
+func (r *ArtifactRepository) Create(ctx context.Context, a *Artifact) (*Artifact, error) {
+	meta, err := json.Marshal(artifactMeta{
+		ContentType:    a.ContentType,
+		SizeBytes:      a.Size,
+		ETag:           a.ETag,
+		DocumentType:   a.DocumentType,
+		ChecksumSHA256: a.ChecksumSHA256,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal artifact metadata: %w", err)
+	}
+
+	created := *a
+	err = r.db.QueryRow(ctx, `
+		INSERT INTO ekyc_artifacts (session_id, type, s3_key, meta_json)
+		VALUES ($1, $2::artifact_type, $3, $4)
+		ON CONFLICT (s3_key) DO UPDATE SET meta_json = EXCLUDED.meta_json
+		RETURNING id::text, created_at`,
+		a.SessionID, a.Type, a.Key, meta,
+	).Scan(&created.ID, &created.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert artifact: %w", err)
+	}
+
+	return &created, nil
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	apperrors "github.com/ekyc-backend/pkg/errors"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/objectkey"
+	"github.com/ekyc-backend/services/storage-svc/internal/repository"
+	"github.com/ekyc-backend/services/storage-svc/internal/upload"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	// DefaultExpiration is used when a caller does not ask for a specific lifetime
+	DefaultExpiration = 15 * time.Minute
+	// MaxExpiration caps how long any upload or download grant stays valid
+	MaxExpiration = time.Hour
+)
+
+// StorageServer implements the StorageService gRPC API
+type StorageServer struct {
+	proto.UnimplementedStorageServiceServer
+
+	artifacts   *repository.ArtifactRepository
+	ingester    *upload.Ingester
+	grants      *upload.Grants
+	transferURL string
+	logger      *logger.Logger
+}
+
+// NewStorageServer creates a new storage gRPC server. Uploads and downloads
+// it authorizes are served by this service's HTTP server at transferURL.
+func NewStorageServer(artifacts *repository.ArtifactRepository, ingester *upload.Ingester, grants *upload.Grants, transferURL string, logger *logger.Logger) *StorageServer {
+	return &StorageServer{
+		artifacts:   artifacts,
+		ingester:    ingester,
+		grants:      grants,
+		transferURL: strings.TrimSuffix(transferURL, "/"),
+		logger:      logger,
+	}
+}
+
+// GetPresignedPostPolicy authorizes the upload of a new artifact of a
+// session. Despite its name it returns no presigned bucket policy, only the
+// upload endpoint of this service and a form carrying a single-use token.
+// The object key is chosen by the server, and the grant behind the token pins
+// the content type and maximum size allowed for the artifact type and the
+// checksum the client declared for the file. The upload handler rejects files
+// whose declared or sniffed type differs from the grant, and posting to this
+// service rather than to the bucket keeps the object's data key from the
+// client.
+func (s *StorageServer) GetPresignedPostPolicy(ctx context.Context, req *proto.GetPresignedPostPolicyRequest) (*proto.GetPresignedPostPolicyResponse, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	policy, ok := upload.PolicyFor(req.GetArtifactType())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "artifact type %s cannot be uploaded", req.GetArtifactType())
+	}
+	if !policy.Allows(req.GetContentType()) {
+		return nil, status.Errorf(codes.InvalidArgument, "content type %q is not allowed for %s", req.GetContentType(), req.GetArtifactType())
+	}
+	documentType, err := upload.DocumentTypeFor(req.GetArtifactType(), req.GetDocumentType())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "document type %s is not valid for %s", req.GetDocumentType(), req.GetArtifactType())
+	}
+	if err := upload.ValidateChecksum(req.GetChecksumSha256()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "checksum_sha256 must be a base64-encoded SHA-256 digest")
+	}
+
+	if err := s.authorizeUpload(ctx, req.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	expiration := clampExpiration(req.GetExpirationSeconds())
+	key := objectkey.New(req.GetSessionId(), req.GetArtifactType().String()).String()
+
+	// The document type and declared checksum are pinned on the object so
+	// they travel with the upload and cannot be changed by the client
+	metadata := map[string]string{upload.ChecksumMetadata: req.GetChecksumSha256()}
+	if name := upload.DocumentTypeName(documentType); name != "" {
+		metadata[upload.DocumentTypeMetadata] = name
+	}
+
+	token, err := s.grants.Issue(ctx, upload.TransferUpload, upload.Grant{
+		ObjectKey:   key,
+		ContentType: req.GetContentType(),
+		MaxSize:     policy.MaxSize,
+		Metadata:    metadata,
+	}, expiration)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	return &proto.GetPresignedPostPolicyResponse{
+		Url:          s.transferURL + "/uploads",
+		FormData:     map[string]string{TokenField: token},
+		ObjectKey:    key,
+		MaxSizeBytes: policy.MaxSize,
+		ExpiresIn:    int32(expiration.Seconds()),
+	}, nil
+}
+
+// GetPresignedGetURL issues a short-lived, single-use download URL for an
+// artifact. The download is served and decrypted by this service.
+func (s *StorageServer) GetPresignedGetURL(ctx context.Context, req *proto.GetPresignedGetURLRequest) (*proto.GetPresignedGetURLResponse, error) {
+	key, err := objectkey.Parse(req.GetObjectKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "object_key is not a session artifact key")
+	}
+
+	if _, err := s.authorizeSession(ctx, key.SessionID); err != nil {
+		return nil, err
+	}
+
+	expiration := clampExpiration(req.GetExpirationSeconds())
+
+	token, err := s.grants.Issue(ctx, upload.TransferDownload, upload.Grant{ObjectKey: key.String()}, expiration)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	return &proto.GetPresignedGetURLResponse{
+		PresignedUrl: s.transferURL + "/downloads/" + token,
+		ExpiresIn:    int32(expiration.Seconds()),
+	}, nil
+}
+
+// ConfirmUpload lets a client ask for an upload to be ingested without waiting
+// for the bucket notification. The object is verified the same way either way.
+func (s *StorageServer) ConfirmUpload(ctx context.Context, req *proto.ConfirmUploadRequest) (*proto.ConfirmUploadResponse, error) {
+	if _, err := uuid.Parse(req.GetSessionId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "session_id must be a valid UUID")
+	}
+
+	key, err := objectkey.Parse(req.GetObjectKey())
+	if err != nil || key.SessionID != req.GetSessionId() {
+		return nil, status.Error(codes.InvalidArgument, "object_key does not belong to the session")
+	}
+
+	if err := s.authorizeUpload(ctx, key.SessionID); err != nil {
+		return nil, err
+	}
+
+	artifact, err := s.ingester.Ingest(ctx, key, "")
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	return &proto.ConfirmUploadResponse{
+		ArtifactId:   artifact.ID,
+		ObjectKey:    artifact.Key,
+		ArtifactType: proto.ArtifactType(proto.ArtifactType_value[artifact.Type]),
+		ContentType:  artifact.ContentType,
+		SizeBytes:    artifact.Size,
+		CreatedAt:    timestamppb.New(artifact.CreatedAt),
+		DocumentType: upload.ParseDocumentType(artifact.DocumentType),
+	}, nil
+}
+
+// authorizeSession loads a session and allows access to its artifacts only
+// for its owner or an admin
+func (s *StorageServer) authorizeSession(ctx context.Context, sessionID string) (*repository.SessionRef, error) {
+	ref, err := s.artifacts.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, s.toStatusError(ctx, err)
+	}
+
+	claims, ok := grpcmw.ClaimsFromContext(ctx)
+	if ok && claims.UserID != ref.UserID && !claims.HasRole("ADMIN") {
+		return nil, status.Error(codes.PermissionDenied, "session does not belong to the caller")
+	}
+
+	return ref, nil
+}
+
+// authorizeUpload checks session access and that the session still accepts uploads
+func (s *StorageServer) authorizeUpload(ctx context.Context, sessionID string) error {
+	ref, err := s.authorizeSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	switch ref.Status {
+	case proto.SessionStatus_APPROVED.String(), proto.SessionStatus_REJECTED.String():
+		return status.Error(codes.FailedPrecondition, "session is already closed")
+	}
+
+	return nil
+}
+
+// toStatusError maps repository and storage errors to gRPC status errors
+func (s *StorageServer) toStatusError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, apperrors.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "session not found")
+	case errors.Is(err, upload.ErrObjectMissing):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, upload.ErrUnknownArtifactType), errors.Is(err, upload.ErrPolicyViolation), errors.Is(err, upload.ErrChecksumMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.WithContext(ctx).Error("Storage request failed", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// clampExpiration converts a requested lifetime in seconds into a duration
+// within (0, MaxExpiration]
+func clampExpiration(seconds int32) time.Duration {
+	expiration := time.Duration(seconds) * time.Second
+	switch {
+	case expiration <= 0:
+		return DefaultExpiration
+	case expiration > MaxExpiration:
+		return MaxExpiration
+	default:
+		return expiration
+	}
+}
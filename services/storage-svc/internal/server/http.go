@@ -0,0 +1,84 @@
+package server
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/storage-svc/internal/upload"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// MaxNotificationSize caps the body accepted from the MinIO webhook target
+const MaxNotificationSize = 1 << 20
+
+// TransferTimeout bounds how long reading an upload or writing a download may take
+const TransferTimeout = 5 * time.Minute
+
+// NewHTTPServer creates the HTTP server exposing health endpoints, the
+// artifact upload and download endpoints and, when a webhook token is
+// configured, the MinIO bucket notification webhook
+func NewHTTPServer(cfg *config.Config, ingester *upload.Ingester, grants *upload.Grants, objects *storage.EncryptedStore, log *logger.Logger) *http.Server {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"status":    "alive",
+			"timestamp": time.Now().UTC().Format(time.RFC3339),
+			"service":   cfg.ServiceName,
+		})
+	})
+
+	// Artifacts move through this service so their data keys never leave it
+	router.POST("/uploads", uploadHandler(grants, objects, log))
+	router.GET("/downloads/:token", downloadHandler(grants, objects, log))
+
+	if cfg.MinIOWebhookToken != "" {
+		router.POST("/minio/events", minioWebhook(cfg.MinIOWebhookToken, ingester, log))
+	}
+
+	return &http.Server{
+		Addr:              cfg.GetHTTPAddr(),
+		Handler:           router,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       TransferTimeout,
+		WriteTimeout:      TransferTimeout,
+		IdleTimeout:       60 * time.Second,
+	}
+}
+
+// minioWebhook receives bucket notifications from a MinIO webhook target.
+// MinIO retries deliveries that do not succeed, so ingestion failures are
+// reported with a 5xx status.
+func minioWebhook(token string, ingester *upload.Ingester, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		presented := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		payload, err := io.ReadAll(io.LimitReader(c.Request.Body, MaxNotificationSize))
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		if err := ingester.HandleNotification(c.Request.Context(), payload); err != nil {
+			log.WithContext(c.Request.Context()).Error("Failed to handle bucket notification", zap.Error(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
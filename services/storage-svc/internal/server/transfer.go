@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/storage-svc/internal/upload"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// TokenField is the upload form field carrying the transfer token. It
+	// must precede the file.
+	TokenField = "token"
+	// FileField is the upload form field carrying the file
+	FileField = "file"
+
+	// MaxUploadRequestSize caps an upload request, leaving room for the form
+	// around the largest artifact
+	MaxUploadRequestSize = upload.MaxLivenessClipSize + 1<<20
+	// maxTokenSize caps the token field of an upload form
+	maxTokenSize = 256
+)
+
+// errUploadTooLarge is returned when an upload exceeds the size of its grant
+var errUploadTooLarge = errors.New("file exceeds the maximum size")
+
+// uploadHandler stores a file uploaded with an upload grant. The file must be
+// of the content type of the grant, both as declared and as sniffed from its
+// first bytes. The object is written with the key, content type and metadata
+// of the grant and encrypted
+// under a data key that never leaves this service; it is ingested once the
+// bucket reports it, like any other write.
+func uploadHandler(grants *upload.Grants, objects *storage.EncryptedStore, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxUploadRequestSize)
+		reader, err := c.Request.MultipartReader()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "expected a multipart/form-data body"})
+			return
+		}
+
+		var grant *upload.Grant
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "malformed multipart body"})
+				return
+			}
+
+			switch part.FormName() {
+			case TokenField:
+				token, err := io.ReadAll(io.LimitReader(part, maxTokenSize))
+				if err != nil {
+					c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "malformed multipart body"})
+					return
+				}
+				grant, err = grants.Redeem(ctx, upload.TransferUpload, string(token))
+				if err != nil {
+					respondGrantError(c, err, log)
+					return
+				}
+			case FileField:
+				if grant == nil {
+					c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "the token field must precede the file"})
+					return
+				}
+				if err := store(ctx, objects, grant, part); err != nil {
+					switch {
+					case errors.Is(err, errUploadTooLarge):
+						c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+					case errors.Is(err, upload.ErrPolicyViolation):
+						c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					default:
+						log.WithContext(ctx).Error("Failed to store upload",
+							zap.String("object_key", grant.ObjectKey),
+							zap.Error(err),
+						)
+						c.AbortWithStatus(http.StatusInternalServerError)
+					}
+					return
+				}
+				c.Status(http.StatusNoContent)
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "the form has no file"})
+	}
+}
+
+// store checks the content type of an uploaded file against its grant,
+// spools it to disk within the size of the grant and writes it to the bucket
+func store(ctx context.Context, objects *storage.EncryptedStore, grant *upload.Grant, part *multipart.Part) error {
+	file := bufio.NewReaderSize(part, upload.SniffLength)
+	head, err := file.Peek(upload.SniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read upload: %w", err)
+	}
+	if len(head) == 0 {
+		return fmt.Errorf("%w: file is empty", upload.ErrPolicyViolation)
+	}
+	if err := upload.CheckContent(grant.ContentType, part.Header.Get("Content-Type"), head); err != nil {
+		return err
+	}
+
+	spool, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create spool file: %w", err)
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	size, err := io.Copy(spool, io.LimitReader(file, grant.MaxSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read upload: %w", err)
+	}
+	if size > grant.MaxSize {
+		return errUploadTooLarge
+	}
+	if err := spool.Close(); err != nil {
+		return fmt.Errorf("failed to write spool file: %w", err)
+	}
+
+	return objects.UploadFile(ctx, grant.ObjectKey, spool.Name(), grant.ContentType, grant.Metadata)
+}
+
+// downloadHandler streams the object of a download grant, decrypting it here
+// so its data key is never handed to the client
+func downloadHandler(grants *upload.Grants, objects *storage.EncryptedStore, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		grant, err := grants.Redeem(ctx, upload.TransferDownload, c.Param("token"))
+		if err != nil {
+			respondGrantError(c, err, log)
+			return
+		}
+
+		info, err := objects.GetFileInfo(ctx, grant.ObjectKey)
+		if err != nil {
+			if storage.IsObjectNotFound(err) {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "object not found"})
+				return
+			}
+			log.WithContext(ctx).Error("Failed to stat download", zap.String("object_key", grant.ObjectKey), zap.Error(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		object, err := objects.GetFile(ctx, grant.ObjectKey)
+		if err != nil {
+			log.WithContext(ctx).Error("Failed to open download", zap.String("object_key", grant.ObjectKey), zap.Error(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		defer object.Close()
+
+		c.DataFromReader(http.StatusOK, info.Size, info.ContentType, object, map[string]string{
+			"Cache-Control": "no-store",
+		})
+	}
+}
+
+// respondGrantError rejects a transfer whose grant could not be redeemed
+func respondGrantError(c *gin.Context, err error, log *logger.Logger) {
+	if errors.Is(err, upload.ErrGrantInvalid) {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	log.WithContext(c.Request.Context()).Error("Failed to redeem transfer grant", zap.Error(err))
+	c.AbortWithStatus(http.StatusInternalServerError)
+}
@@ -0,0 +1,90 @@
+package upload
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekyc-backend/pkg/storage"
+)
+
+// Transfer kinds a grant can authorize
+const (
+	TransferUpload   = "upload"
+	TransferDownload = "download"
+)
+
+// ErrGrantInvalid is returned for transfer tokens that are unknown, expired
+// or already used
+var ErrGrantInvalid = errors.New("transfer grant is invalid or has expired")
+
+// Grant describes a single transfer of one object through the storage
+// service. Clients never reach the bucket themselves, so data keys stay on
+// the server; a grant is what lets a client move bytes for one object.
+type Grant struct {
+	ObjectKey   string            `json:"object_key"`
+	ContentType string            `json:"content_type,omitempty"`
+	MaxSize     int64             `json:"max_size,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// Grants stores transfer grants in Redis under unguessable, single-use tokens
+type Grants struct {
+	redis *storage.Redis
+}
+
+// NewGrants creates a grant store backed by redis
+func NewGrants(redis *storage.Redis) *Grants {
+	return &Grants{redis: redis}
+}
+
+// Issue stores grant for a transfer of the given kind and returns its token.
+// The token is valid once, for at most ttl.
+func (g *Grants) Issue(ctx context.Context, kind string, grant Grant, ttl time.Duration) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate transfer token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	payload, err := json.Marshal(grant)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode transfer grant: %w", err)
+	}
+	if err := g.redis.Set(ctx, grantKey(kind, token), payload, ttl); err != nil {
+		return "", fmt.Errorf("failed to store transfer grant: %w", err)
+	}
+
+	return token, nil
+}
+
+// Redeem consumes the grant behind token. A token can be redeemed only once,
+// whether or not the transfer it authorizes then succeeds.
+func (g *Grants) Redeem(ctx context.Context, kind, token string) (*Grant, error) {
+	if token == "" {
+		return nil, ErrGrantInvalid
+	}
+
+	payload, err := g.redis.GetDel(ctx, grantKey(kind, token))
+	if err != nil {
+		if storage.IsNil(err) {
+			return nil, ErrGrantInvalid
+		}
+		return nil, fmt.Errorf("failed to load transfer grant: %w", err)
+	}
+
+	var grant Grant
+	if err := json.Unmarshal([]byte(payload), &grant); err != nil {
+		return nil, fmt.Errorf("failed to decode transfer grant: %w", err)
+	}
+	return &grant, nil
+}
+
+// grantKey returns the Redis key of a transfer grant
+func grantKey(kind, token string) string {
+	return fmt.Sprintf("transfer:%s:%s", kind, token)
+}
@@ -0,0 +1,172 @@
+package upload
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/objectkey"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/storage-svc/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+var (
+	// ErrUnknownArtifactType is returned for keys whose artifact type clients may not upload
+	ErrUnknownArtifactType = errors.New("artifact type cannot be uploaded")
+	// ErrObjectMissing is returned when the object is not in the bucket
+	ErrObjectMissing = errors.New("object has not been uploaded")
+	// ErrPolicyViolation is returned when the stored object breaks its upload policy
+	ErrPolicyViolation = errors.New("uploaded object violates the upload policy")
+	// ErrStaleNotification is returned when the object changed after a notification was sent
+	ErrStaleNotification = errors.New("object changed since the notification was sent")
+	// ErrChecksumMismatch is returned when the stored object does not hash to
+	// the checksum declared when its upload was authorized
+	ErrChecksumMismatch = errors.New("uploaded object does not match its declared checksum")
+)
+
+// Ingester turns objects observed in the bucket into recorded artifacts.
+// Everything it records has been checked server-side, so downstream services
+// can trust artifact.uploaded events without relying on client callbacks.
+type Ingester struct {
+	artifacts *repository.ArtifactRepository
+	objects   *storage.EncryptedStore
+	bus       events.EventBus
+	logger    *logger.Logger
+}
+
+// NewIngester creates a new upload ingester
+func NewIngester(artifacts *repository.ArtifactRepository, objects *storage.EncryptedStore, bus events.EventBus, logger *logger.Logger) *Ingester {
+	return &Ingester{
+		artifacts: artifacts,
+		objects:   objects,
+		bus:       bus,
+		logger:    logger,
+	}
+}
+
+// Ingest verifies the stored object behind key against the upload policy of
+// its artifact type and the checksum declared for it, records it and
+// publishes artifact.uploaded. The object is hashed here rather than trusting
+// a checksum reported by the store. When expectedETag is set, the stored
+// object must still carry that ETag. Objects that fail verification are
+// deleted.
+func (i *Ingester) Ingest(ctx context.Context, key objectkey.Key, expectedETag string) (*repository.Artifact, error) {
+	artifactType := proto.ArtifactType(proto.ArtifactType_value[key.ArtifactType])
+	policy, ok := PolicyFor(artifactType)
+	if !ok {
+		return nil, ErrUnknownArtifactType
+	}
+
+	info, err := i.objects.GetFileInfo(ctx, key.String())
+	if err != nil {
+		if storage.IsObjectNotFound(err) {
+			return nil, ErrObjectMissing
+		}
+		return nil, err
+	}
+
+	if expectedETag != "" && trimETag(info.ETag) != trimETag(expectedETag) {
+		return nil, ErrStaleNotification
+	}
+
+	if info.Size <= 0 || info.Size > policy.MaxSize || !policy.Allows(info.ContentType) {
+		i.logger.WithContext(ctx).WithSessionID(key.SessionID).Warn("Uploaded object violates upload policy",
+			zap.String("object_key", key.String()),
+			zap.String("content_type", info.ContentType),
+			zap.Int64("size", info.Size),
+		)
+		i.reject(ctx, key)
+		return nil, ErrPolicyViolation
+	}
+
+	declared := info.UserMetadata[ChecksumMetadata]
+	checksum, err := i.checksum(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(checksum), []byte(declared)) != 1 {
+		i.logger.WithContext(ctx).WithSessionID(key.SessionID).Warn("Uploaded object does not match its declared checksum",
+			zap.String("object_key", key.String()),
+			zap.String("declared", declared),
+			zap.String("actual", checksum),
+		)
+		i.reject(ctx, key)
+		return nil, ErrChecksumMismatch
+	}
+
+	artifact, err := i.artifacts.Create(ctx, &repository.Artifact{
+		SessionID:      key.SessionID,
+		Type:           key.ArtifactType,
+		Key:            key.String(),
+		ContentType:    info.ContentType,
+		Size:           info.Size,
+		ETag:           trimETag(info.ETag),
+		DocumentType:   info.UserMetadata[DocumentTypeMetadata],
+		ChecksumSHA256: checksum,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = events.Publish(events.WithSessionID(ctx, artifact.SessionID), i.bus, &proto.ArtifactUploaded{
+		ArtifactId:     artifact.ID,
+		SessionId:      artifact.SessionID,
+		ArtifactType:   artifact.Type,
+		DocumentType:   artifact.DocumentType,
+		ObjectKey:      artifact.Key,
+		ContentType:    artifact.ContentType,
+		SizeBytes:      artifact.Size,
+		Etag:           artifact.ETag,
+		ChecksumSha256: artifact.ChecksumSHA256,
+		UploadedAt:     timestamppb.New(info.LastModified),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish %s: %w", events.SubjectArtifactUploaded, err)
+	}
+
+	i.logger.WithContext(ctx).WithSessionID(artifact.SessionID).Info("Upload ingested",
+		zap.String("artifact_id", artifact.ID),
+		zap.String("type", artifact.Type),
+		zap.Int64("size", artifact.Size),
+	)
+
+	return artifact, nil
+}
+
+// checksum hashes the stored object and returns its base64 SHA-256
+func (i *Ingester) checksum(ctx context.Context, key objectkey.Key) (string, error) {
+	object, err := i.objects.GetFile(ctx, key.String())
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, object); err != nil {
+		return "", fmt.Errorf("failed to hash object: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// reject deletes an object that failed verification
+func (i *Ingester) reject(ctx context.Context, key objectkey.Key) {
+	if err := i.objects.DeleteFile(ctx, key.String()); err != nil {
+		i.logger.WithContext(ctx).Error("Failed to delete rejected object", zap.Error(err))
+	}
+}
+
+// trimETag strips the quotes S3 places around ETag values
+func trimETag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
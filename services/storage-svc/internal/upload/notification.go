@@ -0,0 +1,75 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/objectkey"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"go.uber.org/zap"
+)
+
+// objectCreatedPrefix prefixes the names of every object-created S3 event
+const objectCreatedPrefix = "s3:ObjectCreated:"
+
+// bucketEvent is the payload MinIO delivers to NATS and webhook targets
+type bucketEvent struct {
+	Records []notification.Event `json:"Records"`
+}
+
+// HandleNotification ingests the objects reported by a MinIO bucket event
+// notification. Records that can never succeed, such as keys outside the
+// session layout or objects that break their policy, are logged and dropped;
+// other failures are returned so the delivery can be retried.
+func (i *Ingester) HandleNotification(ctx context.Context, payload []byte) error {
+	var event bucketEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return events.Permanent(fmt.Errorf("failed to unmarshal bucket notification: %w", err))
+	}
+
+	var errs []error
+	for _, record := range event.Records {
+		if !strings.HasPrefix(record.EventName, objectCreatedPrefix) || record.S3.Bucket.Name != i.objects.Bucket() {
+			continue
+		}
+
+		rawKey, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			i.logger.WithContext(ctx).Warn("Dropping notification with malformed key",
+				zap.String("object_key", record.S3.Object.Key),
+			)
+			continue
+		}
+
+		key, err := objectkey.Parse(rawKey)
+		if err != nil {
+			i.logger.WithContext(ctx).Warn("Dropping notification outside the session layout",
+				zap.String("object_key", rawKey),
+			)
+			continue
+		}
+
+		_, err = i.Ingest(ctx, key, record.S3.Object.ETag)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrUnknownArtifactType),
+			errors.Is(err, ErrObjectMissing),
+			errors.Is(err, ErrPolicyViolation),
+			errors.Is(err, ErrChecksumMismatch),
+			errors.Is(err, ErrStaleNotification):
+			i.logger.WithContext(ctx).WithSessionID(key.SessionID).Warn("Dropping bucket notification",
+				zap.String("object_key", rawKey),
+				zap.Error(err),
+			)
+		default:
+			errs = append(errs, fmt.Errorf("failed to ingest %s: %w", rawKey, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,142 @@
+package upload
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+const (
+	megabyte = 1 << 20
+
+	// MaxDocumentSize is the largest accepted identity document image
+	MaxDocumentSize = 10 * megabyte
+	// MaxSelfieSize is the largest accepted selfie image
+	MaxSelfieSize = 5 * megabyte
+	// MaxLivenessClipSize is the largest accepted liveness video
+	MaxLivenessClipSize = 50 * megabyte
+)
+
+// Policy lists what a client may upload for one artifact type
+type Policy struct {
+	ContentTypes []string
+	MaxSize      int64
+}
+
+var imageTypes = []string{"image/jpeg", "image/png"}
+
+// policies holds the upload rules for every artifact type clients may write
+var policies = map[proto.ArtifactType]Policy{
+	proto.ArtifactType_DOC_FRONT:     {ContentTypes: imageTypes, MaxSize: MaxDocumentSize},
+	proto.ArtifactType_DOC_BACK:      {ContentTypes: imageTypes, MaxSize: MaxDocumentSize},
+	proto.ArtifactType_PASSPORT:      {ContentTypes: imageTypes, MaxSize: MaxDocumentSize},
+	proto.ArtifactType_SELFIE:        {ContentTypes: imageTypes, MaxSize: MaxSelfieSize},
+	proto.ArtifactType_LIVENESS_CLIP: {ContentTypes: []string{"video/mp4", "video/webm"}, MaxSize: MaxLivenessClipSize},
+}
+
+// PolicyFor returns the upload policy of an artifact type, if clients may upload it
+func PolicyFor(artifactType proto.ArtifactType) (Policy, bool) {
+	policy, ok := policies[artifactType]
+	return policy, ok
+}
+
+// Allows reports whether contentType may be uploaded under this policy
+func (p Policy) Allows(contentType string) bool {
+	for _, allowed := range p.ContentTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// SniffLength is how many leading bytes of a file CheckContent looks at
+const SniffLength = 512
+
+// CheckContent verifies that an uploaded file is of the content type it was
+// authorized for. The type the client declared for the file, if any, must
+// match, and so must the type sniffed from its first SniffLength bytes, so a
+// file cannot be passed off as an image by its label alone.
+func CheckContent(contentType, declared string, head []byte) error {
+	if declared != "" {
+		mediaType, _, err := mime.ParseMediaType(declared)
+		if err != nil || mediaType != contentType {
+			return fmt.Errorf("%w: file is declared as %q, want %q", ErrPolicyViolation, declared, contentType)
+		}
+	}
+
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if sniffed != contentType {
+		return fmt.Errorf("%w: file content is %q, want %q", ErrPolicyViolation, sniffed, contentType)
+	}
+	return nil
+}
+
+// DocumentTypeMetadata is the user metadata key carrying the document type
+// of an identity document upload, as MinIO reports it back on stat
+const DocumentTypeMetadata = "Document-Type"
+
+// ChecksumMetadata is the user metadata key carrying the base64 SHA-256 the
+// client declared for an upload
+const ChecksumMetadata = "Checksum-Sha256"
+
+// ErrChecksum is returned when a declared checksum is not a base64 SHA-256
+var ErrChecksum = errors.New("checksum must be a base64-encoded SHA-256 digest")
+
+// ValidateChecksum checks that checksum is a base64-encoded SHA-256 digest
+func ValidateChecksum(checksum string) error {
+	digest, err := base64.StdEncoding.DecodeString(checksum)
+	if err != nil || len(digest) != sha256.Size {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// ErrDocumentType is returned when a document type is missing or does not fit the artifact
+var ErrDocumentType = errors.New("invalid document type for artifact")
+
+// cardTypes are the document types whose two sides are uploaded separately
+var cardTypes = map[proto.DocumentType]bool{
+	proto.DocumentType_DOCUMENT_TYPE_CCCD: true,
+	proto.DocumentType_DOCUMENT_TYPE_CMND: true,
+}
+
+// DocumentTypeFor resolves the document type of an upload. Card sides must
+// name their card type; passport uploads are always passports. Other
+// artifacts carry no document type.
+func DocumentTypeFor(artifactType proto.ArtifactType, requested proto.DocumentType) (proto.DocumentType, error) {
+	switch artifactType {
+	case proto.ArtifactType_DOC_FRONT, proto.ArtifactType_DOC_BACK:
+		if !cardTypes[requested] {
+			return 0, ErrDocumentType
+		}
+		return requested, nil
+	case proto.ArtifactType_PASSPORT:
+		if requested != proto.DocumentType_DOCUMENT_TYPE_UNSPECIFIED && requested != proto.DocumentType_DOCUMENT_TYPE_PASSPORT {
+			return 0, ErrDocumentType
+		}
+		return proto.DocumentType_DOCUMENT_TYPE_PASSPORT, nil
+	default:
+		return proto.DocumentType_DOCUMENT_TYPE_UNSPECIFIED, nil
+	}
+}
+
+// DocumentTypeName returns the short name of a document type, such as CCCD,
+// or "" when unspecified
+func DocumentTypeName(documentType proto.DocumentType) string {
+	if documentType == proto.DocumentType_DOCUMENT_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(documentType.String(), "DOCUMENT_TYPE_")
+}
+
+// ParseDocumentType converts a short document type name back into its enum
+func ParseDocumentType(name string) proto.DocumentType {
+	return proto.DocumentType(proto.DocumentType_value["DOCUMENT_TYPE_"+name])
+}
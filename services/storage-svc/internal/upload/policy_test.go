@@ -0,0 +1,79 @@
+package upload
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestValidateChecksum(t *testing.T) {
+	digest := sha256.Sum256([]byte("document"))
+
+	tests := []struct {
+		name     string
+		checksum string
+		wantErr  bool
+	}{
+		{name: "base64 digest", checksum: base64.StdEncoding.EncodeToString(digest[:])},
+		{name: "empty", checksum: "", wantErr: true},
+		{name: "hex digest", checksum: hex.EncodeToString(digest[:]), wantErr: true},
+		{name: "url-safe encoding", checksum: base64.RawURLEncoding.EncodeToString(digest[:]), wantErr: true},
+		{name: "short digest", checksum: base64.StdEncoding.EncodeToString(digest[:16]), wantErr: true},
+		{name: "not base64", checksum: "not a checksum!", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChecksum(tt.checksum)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ValidateChecksum(%q) error = %v, want error %v", tt.checksum, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrChecksum) {
+				t.Fatalf("ValidateChecksum(%q) error = %v, want ErrChecksum", tt.checksum, err)
+			}
+		})
+	}
+}
+
+func TestCheckContent(t *testing.T) {
+	jpeg := []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	mp4 := []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+	webm := []byte("\x1a\x45\xdf\xa3\x9f\x42\x86\x81\x01\x42\xf7\x81\x01\x42\xf2\x81\x04\x42\xf3\x81\x08\x42\x82\x84webm")
+
+	tests := []struct {
+		name        string
+		contentType string
+		declared    string
+		head        []byte
+		wantErr     bool
+	}{
+		{name: "jpeg", contentType: "image/jpeg", declared: "image/jpeg", head: jpeg},
+		{name: "png", contentType: "image/png", declared: "image/png", head: png},
+		{name: "mp4", contentType: "video/mp4", declared: "video/mp4", head: mp4},
+		{name: "webm", contentType: "video/webm", declared: "video/webm", head: webm},
+		{name: "undeclared", contentType: "image/jpeg", head: jpeg},
+		{name: "declared with parameters", contentType: "image/jpeg", declared: "image/jpeg; name=doc.jpg", head: jpeg},
+		{name: "declared as another type", contentType: "image/jpeg", declared: "image/png", head: jpeg, wantErr: true},
+		{name: "declared as octet stream", contentType: "image/jpeg", declared: "application/octet-stream", head: jpeg, wantErr: true},
+		{name: "malformed declaration", contentType: "image/jpeg", declared: "image/", head: jpeg, wantErr: true},
+		{name: "png under a jpeg grant", contentType: "image/jpeg", declared: "image/jpeg", head: png, wantErr: true},
+		{name: "html labelled as jpeg", contentType: "image/jpeg", declared: "image/jpeg", head: []byte("<html><script>alert(1)</script>"), wantErr: true},
+		{name: "pdf labelled as jpeg", contentType: "image/jpeg", head: []byte("%PDF-1.7\n"), wantErr: true},
+		{name: "jpeg under a video grant", contentType: "video/mp4", declared: "video/mp4", head: jpeg, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckContent(tt.contentType, tt.declared, tt.head)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("CheckContent(%q, %q) error = %v, want error %v", tt.contentType, tt.declared, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrPolicyViolation) {
+				t.Errorf("CheckContent(%q, %q) error = %v, want ErrPolicyViolation", tt.contentType, tt.declared, err)
+			}
+		})
+	}
+}
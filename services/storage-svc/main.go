@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ekyc-backend/pkg/config"
+	"github.com/ekyc-backend/pkg/crypto"
+	"github.com/ekyc-backend/pkg/db"
+	"github.com/ekyc-backend/pkg/events"
+	"github.com/ekyc-backend/pkg/grpcmw"
+	"github.com/ekyc-backend/pkg/jwtkeys"
+	"github.com/ekyc-backend/pkg/logger"
+	"github.com/ekyc-backend/pkg/mtls"
+	"github.com/ekyc-backend/pkg/objectkey"
+	"github.com/ekyc-backend/pkg/otel"
+	"github.com/ekyc-backend/pkg/storage"
+	"github.com/ekyc-backend/services/storage-svc/internal/repository"
+	"github.com/ekyc-backend/services/storage-svc/internal/server"
+	"github.com/ekyc-backend/services/storage-svc/internal/upload"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/ekyc-backend/pkg/contracts/proto"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize logger
+	log := logger.New(cfg.ServiceName)
+	defer log.Sync()
+
+	log.Info("Starting Storage service")
+
+	// Initialize OpenTelemetry
+	tp, err := otel.InitTracer(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry tracer", zap.Error(err))
+	}
+
+	mp, err := otel.InitMeter(cfg)
+	if err != nil {
+		log.Error("Failed to initialize OpenTelemetry meter", zap.Error(err))
+	}
+
+	// Initialize database
+	database, err := db.New(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Initialize object storage
+	minioClient, err := storage.NewMinIO(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to MinIO", zap.Error(err))
+	}
+
+	// Initialize envelope encryption. SSE-C keys travel with every request,
+	// so MinIO must be reached over TLS when encryption is on.
+	kms, err := crypto.LoadKMS(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to load KMS", zap.Error(err))
+	}
+	if kms != nil && !cfg.MinIOUseSSL {
+		log.Fatal("Artifact encryption requires MINIO_USE_SSL=true")
+	}
+	objects := storage.NewEncryptedStore(minioClient, kms, storage.NewDataKeys(database), log)
+
+	// Initialize event bus
+	bus, err := events.NewJetStreamEventBus(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to NATS", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// Initialize repositories and gRPC handlers
+	artifacts := repository.NewArtifactRepository(database)
+	ingester := upload.NewIngester(artifacts, objects, bus, log)
+
+	// Ingest uploads as MinIO reports them, so sessions only advance on
+	// objects the server has observed
+	if cfg.MinIONotifyARN != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := minioClient.EnableNotifications(ctx, cfg.MinIONotifyARN, objectkey.Prefix+"/")
+		cancel()
+		if err != nil {
+			log.Fatal("Failed to enable bucket notifications", zap.Error(err))
+		}
+	}
+	if err := bus.Subscribe(context.Background(), cfg.MinIONotifySubject, cfg.ServiceName, ingester.HandleNotification); err != nil {
+		log.Fatal("Failed to subscribe to bucket notifications", zap.Error(err))
+	}
+
+	// Initialize Redis for transfer grants and the token denylist written by
+	// the gateway
+	redisClient, err := storage.NewRedis(cfg, log)
+	if err != nil {
+		log.Fatal("Failed to connect to Redis", zap.Error(err))
+	}
+	defer redisClient.Close()
+
+	// Uploads and downloads are authorized by single-use grants and served
+	// over HTTP by this service
+	grants := upload.NewGrants(redisClient)
+	storageServer := server.NewStorageServer(artifacts, ingester, grants, cfg.StoragePublicURL, log)
+
+	// Initialize token verification against the gateway's published keys and
+	// revocations
+	verifier := jwtkeys.NewVerifier(jwtkeys.NewRemoteKeySet(cfg.JWKSURL, jwtkeys.DefaultJWKSRefreshInterval), cfg.JWTIssuer)
+	denylist := jwtkeys.NewDenylist(redisClient)
+	policy := grpcmw.NewAuthPolicy().
+		RequireAnyRole("/ekyc.StorageService/GetPresignedPostPolicy", "USER", "ADMIN").
+		RequireAnyRole("/ekyc.StorageService/ConfirmUpload", "USER", "ADMIN").
+		RequireAnyRole("/ekyc.StorageService/GetPresignedGetURL", "ADMIN")
+
+	// Initialize mTLS for service-to-service traffic
+	tlsSource, err := mtls.Load(mtls.OptionsFromConfig(cfg), log)
+	if err != nil {
+		log.Fatal("Failed to load mTLS configuration", zap.Error(err))
+	}
+	if tlsSource != nil {
+		defer tlsSource.Close()
+	}
+
+	// Only the gateway calls this service
+	peers := mtls.NewPeerPolicy(cfg.TLSTrustDomain).Allow("api-gateway")
+
+	// Initialize gRPC server
+	serverOptions := append(mtls.ServerOptions(tlsSource, peers),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryTracingInterceptor(cfg.ServiceName),
+			grpcmw.UnaryLoggingInterceptor(log),
+			grpcmw.UnaryAuthInterceptor(verifier, denylist, policy, log),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamTracingInterceptor(cfg.ServiceName),
+			grpcmw.StreamLoggingInterceptor(log),
+			grpcmw.StreamAuthInterceptor(verifier, denylist, policy, log),
+		),
+	)
+	grpcServer := grpc.NewServer(serverOptions...)
+	proto.RegisterStorageServiceServer(grpcServer, storageServer)
+
+	listener, err := net.Listen("tcp", cfg.GetGRPCAddr())
+	if err != nil {
+		log.Fatal("Failed to listen for gRPC", zap.Error(err))
+	}
+
+	go func() {
+		log.Info("Starting gRPC server", zap.String("addr", cfg.GetGRPCAddr()))
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	// Initialize HTTP server for health checks and artifact transfers
+	httpServer := server.NewHTTPServer(cfg, ingester, grants, objects, log)
+
+	go func() {
+		log.Info("Starting HTTP server", zap.String("addr", cfg.GetHTTPAddr()))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Info("Shutting down server...")
+
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcServer.GracefulStop()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Error("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	if err := otel.Shutdown(ctx, tp, mp); err != nil {
+		log.Error("Failed to shutdown OpenTelemetry", zap.Error(err))
+	}
+
+	log.Info("Server exited")
+}